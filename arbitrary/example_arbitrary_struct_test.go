@@ -53,12 +53,12 @@ func Example_arbitrary_structs() {
 
 	properties.Run(gopter.ConsoleReporter(false))
 	// Output:
-	// ! MyInt64: Falsified after 6 passed tests.
+	// ! MyInt64: Falsified after 2 passed tests.
 	// ARG_0: -1000
-	// ARG_0_ORIGINAL (54 shrinks): -1601066829744837253
+	// ARG_0_ORIGINAL (56 shrinks): -4614293833289261200
 	// ! MyUInt32Type: Falsified after 0 passed tests.
 	// ARG_0: 2000
-	// ARG_0_ORIGINAL (23 shrinks): 2161922319
+	// ARG_0_ORIGINAL (23 shrinks): 4238691970
 	// + Foo: OK, passed 100 tests.
 	// + Foo2: OK, passed 100 tests.
 }
@@ -0,0 +1,118 @@
+package gopter
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// BenchmarkParameters to run benchmarks with generated values
+type BenchmarkParameters struct {
+	// Sizes of the generated values, every size is run as a sub-benchmark
+	Sizes []int
+	// PoolSize is the number of values generated (outside the timer) for every size
+	PoolSize int
+	// MaxDiscardRatio limits the number of empty results of the generator (relative to PoolSize)
+	MaxDiscardRatio float64
+	Seed            int64
+}
+
+// DefaultBenchmarkParameters creates reasonable default parameters for most benchmarks.
+// Contrary to DefaultTestParameters the seed is fixed, so that subsequent runs of a
+// benchmark are comparable.
+func DefaultBenchmarkParameters() *BenchmarkParameters {
+	return &BenchmarkParameters{
+		Sizes:           []int{1, 10, 100},
+		PoolSize:        100,
+		MaxDiscardRatio: 5,
+		Seed:            1234,
+	}
+}
+
+// Benchmark runs fn with values of gen using the default benchmark parameters.
+// See BenchmarkWithParameters for details.
+func Benchmark(b *testing.B, gen Gen, fn interface{}) {
+	BenchmarkWithParameters(b, DefaultBenchmarkParameters(), gen, fn)
+}
+
+// BenchmarkWithParameters runs fn with values of gen as a sub-benchmark for every size
+// of the parameters (named "size=<size>"), reporting ns/op and allocations per size.
+// The values are generated before the timer is started and are used round-robin.
+// fn: has to be a func(T) or a func(T) bool (where T is the type of the generated values or
+// interface{}). In the latter case fn is considered to be a property that is checked for every
+// benchmarked value, i.e. the benchmark fails if fn returns false.
+// Note: Unless T is interface{}, fn is called via reflection, which adds a constant overhead to
+// every operation.
+func BenchmarkWithParameters(b *testing.B, parameters *BenchmarkParameters, gen Gen, fn interface{}) {
+	check := benchmarkCheck(gen, fn)
+
+	for _, size := range parameters.Sizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			pool, err := benchmarkPool(parameters, gen, size)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				value := pool[i%len(pool)]
+				if !check(value) {
+					b.Fatalf("Property falsified for %#v (seed %d, size %d)", value, parameters.Seed, size)
+				}
+			}
+		})
+	}
+}
+
+// benchmarkCheck converts fn to a check of a generated value
+func benchmarkCheck(gen Gen, fn interface{}) func(interface{}) bool {
+	switch f := fn.(type) {
+	case func(interface{}):
+		return func(value interface{}) bool {
+			f(value)
+			return true
+		}
+	case func(interface{}) bool:
+		return f
+	}
+
+	fnVal := reflect.ValueOf(fn)
+	if fnVal.Kind() != reflect.Func || fnVal.Type().NumIn() != 1 || fnVal.Type().NumOut() > 1 ||
+		(fnVal.Type().NumOut() == 1 && fnVal.Type().Out(0).Kind() != reflect.Bool) {
+		panic(fmt.Sprintf("Param of Benchmark has to be a func(T) or func(T) bool, but is %T", fn))
+	}
+	paramType := fnVal.Type().In(0)
+	if resultType := gen(DefaultGenParams).ResultType; resultType != nil && !resultType.ConvertibleTo(paramType) {
+		panic(fmt.Sprintf("Param of Benchmark has to be a func with one param convertible from %v, but is %v", resultType, paramType))
+	}
+	return func(value interface{}) bool {
+		param := reflect.Zero(paramType)
+		if value != nil {
+			param = reflect.ValueOf(value).Convert(paramType)
+		}
+		results := fnVal.Call([]reflect.Value{param})
+		return len(results) == 0 || results[0].Bool()
+	}
+}
+
+// benchmarkPool generates the values of a sub-benchmark
+func benchmarkPool(parameters *BenchmarkParameters, gen Gen, size int) ([]interface{}, error) {
+	genParams := DefaultGenParameters().CloneWithSeed(parameters.Seed).WithSize(size)
+	poolSize := parameters.PoolSize
+	if poolSize < 1 {
+		poolSize = 1
+	}
+	pool := make([]interface{}, 0, poolSize)
+	maxDiscarded := int(float64(poolSize) * parameters.MaxDiscardRatio)
+	for discarded := 0; len(pool) < poolSize; {
+		value, ok := gen(genParams).Retrieve()
+		if !ok {
+			if discarded++; discarded > maxDiscarded {
+				return nil, fmt.Errorf("generator exhausted after %d values (seed %d, size %d)", len(pool), parameters.Seed, size)
+			}
+			continue
+		}
+		pool = append(pool, value)
+	}
+	return pool, nil
+}
@@ -0,0 +1,100 @@
+package gopter_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+)
+
+func TestBenchmark(t *testing.T) {
+	parameters := gopter.DefaultBenchmarkParameters()
+	parameters.Sizes = []int{0, 5}
+	parameters.PoolSize = 10
+	var maxLen int
+	result := testing.Benchmark(func(b *testing.B) {
+		gopter.BenchmarkWithParameters(b, parameters, gen.SliceOf(gen.Int()), func(value interface{}) {
+			if l := len(value.([]int)); l > maxLen {
+				maxLen = l
+			}
+		})
+	})
+	if result.N == 0 {
+		t.Errorf("Benchmark failed: %#v", result)
+	}
+	if maxLen == 0 || maxLen > 5 {
+		t.Errorf("Invalid size of benchmarked values: %d", maxLen)
+	}
+
+	// a falsified property stops the sub-benchmark of each size
+	calls := 0
+	testing.Benchmark(func(b *testing.B) {
+		gopter.BenchmarkWithParameters(b, parameters, gen.Int(), func(value interface{}) bool {
+			calls++
+			return false
+		})
+	})
+	if calls != len(parameters.Sizes) {
+		t.Errorf("Benchmark of falsified property not stopped: %d calls", calls)
+	}
+
+	calls = 0
+	testing.Benchmark(func(b *testing.B) {
+		gopter.BenchmarkWithParameters(b, parameters, gen.Int().SuchThat(func(int) bool {
+			return false
+		}), func(value interface{}) {
+			calls++
+		})
+	})
+	if calls != 0 {
+		t.Errorf("Benchmark of exhausted generator not stopped: %d calls", calls)
+	}
+}
+
+func TestBenchmarkTypedFunc(t *testing.T) {
+	parameters := gopter.DefaultBenchmarkParameters()
+	parameters.Sizes = []int{5}
+	var lengths []int
+	result := testing.Benchmark(func(b *testing.B) {
+		gopter.BenchmarkWithParameters(b, parameters, gen.SliceOf(gen.Int()), func(value []int) {
+			lengths = append(lengths, len(value))
+		})
+	})
+	if result.N == 0 || len(lengths) == 0 {
+		t.Errorf("Benchmark failed: %#v", result)
+	}
+	for _, length := range lengths {
+		if length > 5 {
+			t.Errorf("Invalid length: %d", length)
+		}
+	}
+
+	calls := 0
+	testing.Benchmark(func(b *testing.B) {
+		gopter.BenchmarkWithParameters(b, parameters, gen.IntRange(1, 10), func(value int64) bool {
+			calls++
+			return value < 1
+		})
+	})
+	if calls != 1 {
+		t.Errorf("Benchmark of falsified property not stopped: %d calls", calls)
+	}
+}
+
+func TestBenchmarkInvalidFunc(t *testing.T) {
+	defer expectPanic(t, "Param of Benchmark has to be a func(T) or func(T) bool, but is func(int) int")
+	gopter.Benchmark(&testing.B{}, gen.Int(), func(int) int { return 0 })
+}
+
+func TestBenchmarkInvalidParam(t *testing.T) {
+	defer expectPanic(t, "Param of Benchmark has to be a func with one param convertible from int, but is []int")
+	gopter.Benchmark(&testing.B{}, gen.Int(), func([]int) {})
+}
+
+func BenchmarkJSONMarshal(b *testing.B) {
+	gopter.Benchmark(b, gen.MapOf(gen.Identifier(), gen.Float64()), func(value interface{}) bool {
+		_, err := json.Marshal(value)
+		return err == nil
+	})
+}
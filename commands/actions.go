@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
@@ -11,6 +12,8 @@ import (
 type shrinkableCommand struct {
 	command  Command
 	shrinker gopter.Shrinker
+	// result is the symbolic variable bound to the result of the command
+	result Var
 }
 
 func (s shrinkableCommand) shrink() gopter.Shrink {
@@ -18,6 +21,7 @@ func (s shrinkableCommand) shrink() gopter.Shrink {
 		return shrinkableCommand{
 			command:  command,
 			shrinker: s.shrinker,
+			result:   s.result,
 		}
 	})
 }
@@ -35,21 +39,73 @@ type actions struct {
 }
 
 func (a *actions) String() string {
-	return fmt.Sprintf("initialState=%v sequential=%s", a.initialStateProvider(), a.sequentialCommands)
+	// the variables referred to by a command are shown with the command they are bound to
+	referred := map[Var]bool{}
+	for _, command := range a.sequentialCommands {
+		for _, v := range commandVars(command.command) {
+			referred[v] = true
+		}
+	}
+	commands := make([]string, len(a.sequentialCommands))
+	for i, command := range a.sequentialCommands {
+		if referred[command.result] {
+			commands[i] = fmt.Sprintf("%v=%v", command.result, command)
+		} else {
+			commands[i] = command.String()
+		}
+	}
+	return fmt.Sprintf("initialState=%v sequential=[%s]", a.initialStateProvider(), strings.Join(commands, " "))
 }
 
-func (a *actions) run(systemUnderTest SystemUnderTest) (*gopter.PropResult, error) {
+// run applies the commands to the system under test, the step by step trace of the execution
+// is added to the result
+func (a *actions) run(commands Commands, systemUnderTest SystemUnderTest) (*gopter.PropResult, error) {
+	invariantCommands, checkInvariant := commands.(InvariantCommands)
 	state := a.initialStateProvider()
+	env := Env{}
 	propResult := &gopter.PropResult{Status: gopter.PropTrue}
+	trace := make([]*gopter.TraceStep, 0, len(a.sequentialCommands))
+	withTrace := func(propResult *gopter.PropResult) (*gopter.PropResult, error) {
+		traced := *propResult
+		traced.Trace = trace
+		return &traced, nil
+	}
 	for _, shrinkableCommand := range a.sequentialCommands {
+		step := &gopter.TraceStep{
+			Name:     shrinkableCommand.command.String(),
+			PreState: fmt.Sprintf("%v", state),
+		}
+		trace = append(trace, step)
 		if !shrinkableCommand.command.PreCondition(state) {
-			return &gopter.PropResult{Status: gopter.PropFalse}, nil
+			step.PostState = step.PreState
+			step.Status = gopter.PropFalse
+			step.Labels = []string{"Precondition failed"}
+			return withTrace(&gopter.PropResult{Status: gopter.PropFalse})
+		}
+		result, err := runCommand(shrinkableCommand.command, systemUnderTest, env)
+		step.Result = result
+		if err != nil {
+			step.PostState = step.PreState
+			step.Status = gopter.PropError
+			step.Labels = []string{err.Error()}
+			return withTrace(&gopter.PropResult{
+				Status: gopter.PropError,
+				Error:  fmt.Errorf("%v failed: %w", shrinkableCommand.command, err),
+			})
+		}
+		env[shrinkableCommand.result] = result
+		preState := state
+		state = nextState(shrinkableCommand.command, state, shrinkableCommand.result)
+		stepResult := postCondition(shrinkableCommand.command, preState, state, result)
+		if checkInvariant {
+			stepResult = stepResult.And(invariantCommands.Invariant(state, systemUnderTest))
 		}
-		result := shrinkableCommand.command.Run(systemUnderTest)
-		state = shrinkableCommand.command.NextState(state)
-		propResult = propResult.And(shrinkableCommand.command.PostCondition(state, result))
+		step.PostState = fmt.Sprintf("%v", state)
+		step.Status = stepResult.Status
+		step.Labels = stepResult.Labels
+		propResult = propResult.And(stepResult)
 	}
-	return propResult, nil
+	return withTrace(propResult)
 }
 
 type sizedCommands struct {
@@ -65,11 +121,35 @@ func actionsShrinker(v interface{}) gopter.Shrink {
 	return gen.SliceShrinker(elementShrinker)(a.sequentialCommands).Map(func(v []shrinkableCommand) *actions {
 		return &actions{
 			initialStateProvider: a.initialStateProvider,
-			sequentialCommands:   v,
+			sequentialCommands:   dropUnbound(v),
 		}
 	})
 }
 
+// dropUnbound drops the commands referring to a symbolic variable that is not bound by
+// an earlier command (e.g. because the earlier command has been dropped while shrinking)
+func dropUnbound(commands []shrinkableCommand) []shrinkableCommand {
+	bound := make(map[Var]bool, len(commands))
+	result := make([]shrinkableCommand, 0, len(commands))
+	for _, command := range commands {
+		if !allBound(bound, commandVars(command.command)) {
+			continue
+		}
+		bound[command.result] = true
+		result = append(result, command)
+	}
+	return result
+}
+
+func allBound(bound map[Var]bool, vars []Var) bool {
+	for _, v := range vars {
+		if !bound[v] {
+			return false
+		}
+	}
+	return true
+}
+
 func genActions(commands Commands) gopter.Gen {
 	genInitialState := commands.GenInitialState()
 	genInitialStateProvider := gopter.Gen(func(params *gopter.GenParameters) *gopter.GenResult {
@@ -94,11 +174,14 @@ func genActions(commands Commands) gopter.Gen {
 			}
 		}).SuchThat(func(actions *actions) bool {
 			state := actions.initialStateProvider()
+			bound := make(map[Var]bool, len(actions.sequentialCommands))
 			for _, shrinkableCommand := range actions.sequentialCommands {
-				if !shrinkableCommand.command.PreCondition(state) {
+				if !allBound(bound, commandVars(shrinkableCommand.command)) ||
+					!shrinkableCommand.command.PreCondition(state) {
 					return false
 				}
-				state = shrinkableCommand.command.NextState(state)
+				bound[shrinkableCommand.result] = true
+				state = nextState(shrinkableCommand.command, state, shrinkableCommand.result)
 			}
 			return true
 		}).WithShrinker(actionsShrinker)
@@ -122,12 +205,16 @@ func genSizedCommands(commands Commands, initialStateProvider func() State) gopt
 						return gopter.NewEmptyResult(reflect.TypeOf(sizedCommands{}))
 					}
 					command := value.(Command)
+					// the variables are numbered in the order of generation, so that they remain
+					// unique if commands are dropped while shrinking
+					resultVar := Var(len(prev.commands) + 1)
 					return gopter.NewGenResult(
 						sizedCommands{
-							state: command.NextState(prev.state),
+							state: nextState(command, prev.state, resultVar),
 							commands: append(prev.commands, shrinkableCommand{
 								command:  command,
 								shrinker: result.Shrinker,
+								result:   resultVar,
 							}),
 						},
 						gopter.NoShrinker,
@@ -26,6 +26,20 @@ type Command interface {
 	String() string
 }
 
+// ExtendedCommand is an optional extension of the Command interface for commands that may fail
+// with an error or need the state before the command is applied to check the postcondition.
+type ExtendedCommand interface {
+	Command
+	// RunWithError applies the command to the system under test, env contains the results of the
+	// earlier commands (see VarCommand). An error is reported as an error of the property.
+	// It is used instead of Run (and RunWithEnv).
+	RunWithError(systemUnderTest SystemUnderTest, env Env) (Result, error)
+	// PostConditionWithPreState checks if the state is valid after the command is applied,
+	// preState is the state before the command is applied.
+	// It is used instead of PostCondition.
+	PostConditionWithPreState(preState, postState State, result Result) *gopter.PropResult
+}
+
 // ProtoCommand is a prototype implementation of the Command interface
 type ProtoCommand struct {
 	Name              string
@@ -33,6 +47,15 @@ type ProtoCommand struct {
 	NextStateFunc     func(state State) State
 	PreConditionFunc  func(state State) bool
 	PostConditionFunc func(state State, result Result) *gopter.PropResult
+	// NextStateWithVarFunc, RunWithEnvFunc and UsedVars implement the VarCommand interface,
+	// if not set NextStateFunc and RunFunc are used
+	NextStateWithVarFunc func(state State, result Var) State
+	RunWithEnvFunc       func(systemUnderTest SystemUnderTest, env Env) Result
+	UsedVars             []Var
+	// RunWithErrorFunc and PostConditionWithPreStateFunc implement the ExtendedCommand interface,
+	// if not set RunWithEnvFunc, RunFunc and PostConditionFunc are used
+	RunWithErrorFunc              func(systemUnderTest SystemUnderTest, env Env) (Result, error)
+	PostConditionWithPreStateFunc func(preState, postState State, result Result) *gopter.PropResult
 }
 
 // Run applies the command to the system under test
@@ -67,6 +90,64 @@ func (p *ProtoCommand) PostCondition(state State, result Result) *gopter.PropRes
 	return &gopter.PropResult{Status: gopter.PropTrue}
 }
 
+// NextStateWithVar calculates the next expected state if the command is applied, result is
+// the symbolic variable that will be bound to the result of the command
+func (p *ProtoCommand) NextStateWithVar(state State, result Var) State {
+	if p.NextStateWithVarFunc != nil {
+		return p.NextStateWithVarFunc(state, result)
+	}
+	return p.NextState(state)
+}
+
+// Vars returns the symbolic variables the command refers to
+func (p *ProtoCommand) Vars() []Var {
+	return p.UsedVars
+}
+
+// RunWithEnv applies the command to the system under test, env contains the results of the
+// earlier commands
+func (p *ProtoCommand) RunWithEnv(systemUnderTest SystemUnderTest, env Env) Result {
+	if p.RunWithEnvFunc != nil {
+		return p.RunWithEnvFunc(systemUnderTest, env)
+	}
+	return p.Run(systemUnderTest)
+}
+
+// RunWithError applies the command to the system under test, env contains the results of the
+// earlier commands
+func (p *ProtoCommand) RunWithError(systemUnderTest SystemUnderTest, env Env) (Result, error) {
+	if p.RunWithErrorFunc != nil {
+		return p.RunWithErrorFunc(systemUnderTest, env)
+	}
+	return p.RunWithEnv(systemUnderTest, env), nil
+}
+
+// PostConditionWithPreState checks if the state is valid after the command is applied,
+// preState is the state before the command is applied
+func (p *ProtoCommand) PostConditionWithPreState(preState, postState State, result Result) *gopter.PropResult {
+	if p.PostConditionWithPreStateFunc != nil {
+		return p.PostConditionWithPreStateFunc(preState, postState, result)
+	}
+	return p.PostCondition(postState, result)
+}
+
 func (p *ProtoCommand) String() string {
 	return p.Name
 }
+
+func runCommand(command Command, systemUnderTest SystemUnderTest, env Env) (Result, error) {
+	if extendedCommand, ok := command.(ExtendedCommand); ok {
+		return extendedCommand.RunWithError(systemUnderTest, env)
+	}
+	if varCommand, ok := command.(VarCommand); ok {
+		return varCommand.RunWithEnv(systemUnderTest, env), nil
+	}
+	return command.Run(systemUnderTest), nil
+}
+
+func postCondition(command Command, preState, postState State, result Result) *gopter.PropResult {
+	if extendedCommand, ok := command.(ExtendedCommand); ok {
+		return extendedCommand.PostConditionWithPreState(preState, postState, result)
+	}
+	return command.PostCondition(postState, result)
+}
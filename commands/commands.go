@@ -26,6 +26,14 @@ type Commands interface {
 	InitialPreCondition(state State) bool
 }
 
+// InvariantCommands is an optional extension of the Commands interface to check invariants of
+// the system under test after every command
+type InvariantCommands interface {
+	Commands
+	// Invariant checks the system under test against the expected state after a command is applied
+	Invariant(state State, systemUnderTest SystemUnderTest) *gopter.PropResult
+}
+
 // ProtoCommands is a prototype implementation of the Commands interface
 type ProtoCommands struct {
 	NewSystemUnderTestFunc     func(initialState State) SystemUnderTest
@@ -33,6 +41,8 @@ type ProtoCommands struct {
 	InitialStateGen            gopter.Gen
 	GenCommandFunc             func(State) gopter.Gen
 	InitialPreConditionFunc    func(State) bool
+	// InvariantFunc implements the InvariantCommands interface
+	InvariantFunc func(State, SystemUnderTest) *gopter.PropResult
 }
 
 // NewSystemUnderTest should create a new/isolated system under test
@@ -73,12 +83,20 @@ func (p *ProtoCommands) InitialPreCondition(state State) bool {
 	return true
 }
 
+// Invariant checks the system under test against the expected state after a command is applied
+func (p *ProtoCommands) Invariant(state State, systemUnderTest SystemUnderTest) *gopter.PropResult {
+	if p.InvariantFunc != nil {
+		return p.InvariantFunc(state, systemUnderTest)
+	}
+	return &gopter.PropResult{Status: gopter.PropTrue}
+}
+
 // Prop creates a gopter.Prop from Commands
 func Prop(commands Commands) gopter.Prop {
 	return prop.ForAll(func(actions *actions) (*gopter.PropResult, error) {
 		systemUnderTest := commands.NewSystemUnderTest(actions.initialStateProvider())
 		defer commands.DestroySystemUnderTest(systemUnderTest)
 
-		return actions.run(systemUnderTest)
+		return actions.run(commands, systemUnderTest)
 	}, genActions(commands))
 }
@@ -7,5 +7,16 @@ ProtoCommands as prototype.
 
 The commands themselves have to implement the Command interface, whereas
 testers might choose to use ProtoCommand as prototype.
+
+Commands referring to the results of earlier commands (e.g. the id of a created entity) may
+implement the VarCommand interface: The expected state records the symbolic variable (Var) of
+the result of a command, which is bound to the actual result when the commands are run (Env).
+Commands that may fail with an error or check their postcondition against the state before the
+command is applied may implement the ExtendedCommand interface, and invariants of the system under
+test are checked after every command if the Commands implement the InvariantCommands interface.
+
+If the commands are falsified the result contains a step by step trace of the (shrinked) commands
+(see gopter.TraceStep), i.e. the expected states before and after every command, the actual
+results and the verdicts of the postconditions.
 */
 package commands
@@ -235,22 +235,73 @@ func Example_circularqueue() {
 	// When using testing.T you might just use: properties.TestingRun(t)
 	properties.Run(gopter.ConsoleReporter(false))
 	// Output:
-	// ! circular buffer: Falsified after 96 passed tests.
-	// ARG_0: initialState=State(size=7, elements=[]) sequential=[Put(0) Put(0)
-	//    Get Put(0) Get Put(0) Put(0) Get Put(0) Get Put(0) Get Put(-1) Put(0)
-	//    Put(0) Put(0) Put(0) Get Get Put(2) Get]
-	// ARG_0_ORIGINAL (85 shrinks): initialState=State(size=7, elements=[])
-	//    sequential=[Put(-1855365712) Put(-1591723498) Get Size Size
-	//    Put(-1015561691) Get Put(397128011) Size Get Put(1943174048) Size
-	//    Put(1309500770) Size Get Put(-879438231) Size Get Put(-1644094687) Get
-	//    Put(-1818606323) Size Put(488620313) Size Put(-1219794505)
-	//    Put(1166147059) Get Put(11390361) Get Size Put(-1407993944) Get Get Size
-	//    Put(1393923085) Get Put(1222853245) Size Put(2070918543) Put(1741323168)
-	//    Size Get Get Size Put(2019939681) Get Put(-170089451) Size Get Get Size
-	//    Size Put(-49249034) Put(1229062846) Put(642598551) Get Put(1183453167)
-	//    Size Get Get Get Put(1010460728) Put(6828709) Put(-185198587) Size Size
-	//    Get Put(586459644) Get Size Put(-1802196502) Get Size Put(2097590857) Get
-	//    Get Get Get Size Put(-474576011) Size Get Size Size Put(771190414) Size
-	//    Put(-1509199920) Get Put(967212411) Size Get Put(578995532) Size Get Size
-	//    Get]
+	// ! circular buffer: Falsified after 64 passed tests.
+	// > Trace of failing property:
+	// 1. Put(0) returned 0: TRUE
+	//    state: State(size=7, elements=[]) -> State(size=7, elements=[0])
+	// 2. Get returned 0: TRUE
+	//    state: State(size=7, elements=[0]) -> State(size=7, elements=[])
+	// 3. Put(0) returned 0: TRUE
+	//    state: State(size=7, elements=[]) -> State(size=7, elements=[0])
+	// 4. Put(0) returned 0: TRUE
+	//    state: State(size=7, elements=[0]) -> State(size=7, elements=[0 0])
+	// 5. Put(0) returned 0: TRUE
+	//    state: State(size=7, elements=[0 0]) -> State(size=7, elements=[0 0 0])
+	// 6. Put(0) returned 0: TRUE
+	//    state: State(size=7, elements=[0 0 0]) -> State(size=7, elements=[0 0 0
+	//    0])
+	// 7. Get returned 0: TRUE
+	//    state: State(size=7, elements=[0 0 0 0]) -> State(size=7, elements=[0 0
+	//    0])
+	// 8. Get returned 0: TRUE
+	//    state: State(size=7, elements=[0 0 0]) -> State(size=7, elements=[0 0])
+	// 9. Put(0) returned 0: TRUE
+	//    state: State(size=7, elements=[0 0]) -> State(size=7, elements=[0 0 0])
+	// 10. Get returned 0: TRUE
+	//    state: State(size=7, elements=[0 0 0]) -> State(size=7, elements=[0 0])
+	// 11. Put(0) returned 0: TRUE
+	//    state: State(size=7, elements=[0 0]) -> State(size=7, elements=[0 0 0])
+	// 12. Put(-1) returned -1: TRUE
+	//    state: State(size=7, elements=[0 0 0]) -> State(size=7, elements=[0 0 0
+	//    -1])
+	// 13. Put(0) returned 0: TRUE
+	//    state: State(size=7, elements=[0 0 0 -1]) -> State(size=7, elements=[0
+	//    0 0 -1 0])
+	// 14. Put(0) returned 0: TRUE
+	//    state: State(size=7, elements=[0 0 0 -1 0]) -> State(size=7,
+	//    elements=[0 0 0 -1 0 0])
+	// 15. Get returned 0: TRUE
+	//    state: State(size=7, elements=[0 0 0 -1 0 0]) -> State(size=7,
+	//    elements=[0 0 -1 0 0])
+	// 16. Get returned 0: TRUE
+	//    state: State(size=7, elements=[0 0 -1 0 0]) -> State(size=7,
+	//    elements=[0 -1 0 0])
+	// 17. Put(0) returned 0: TRUE
+	//    state: State(size=7, elements=[0 -1 0 0]) -> State(size=7, elements=[0
+	//    -1 0 0 0])
+	// 18. Put(0) returned 0: TRUE
+	//    state: State(size=7, elements=[0 -1 0 0 0]) -> State(size=7,
+	//    elements=[0 -1 0 0 0 0])
+	// 19. Put(2) returned 2: TRUE
+	//    state: State(size=7, elements=[0 -1 0 0 0 0]) -> State(size=7,
+	//    elements=[0 -1 0 0 0 0 2])
+	// 20. Get returned 0: TRUE
+	//    state: State(size=7, elements=[0 -1 0 0 0 0 2]) -> State(size=7,
+	//    elements=[-1 0 0 0 0 2])
+	// 21. Get returned -2: FALSE
+	//    state: State(size=7, elements=[-1 0 0 0 0 2]) -> State(size=7,
+	//    elements=[0 0 0 0 2])
+	// ARG_0: initialState=State(size=7, elements=[]) sequential=[Put(0) Get
+	//    Put(0) Put(0) Put(0) Put(0) Get Get Put(0) Get Put(0) Put(-1) Put(0)
+	//    Put(0) Get Get Put(0) Put(0) Put(2) Get Get]
+	// ARG_0_ORIGINAL (80 shrinks): initialState=State(size=7, elements=[])
+	//    sequential=[Size Put(1787270174) Get Put(-134561664) Size Put(-414007483)
+	//    Put(-1629214954) Put(2088825341) Get Get Size Put(1547588388) Size Size
+	//    Get Put(0) Put(-530378392) Put(157713229) Put(-1785392776) Get Get Size
+	//    Put(-1) Put(737420960) Size Size Put(1673646609) Size Get
+	//    Put(-2102931467) Get Size Size Get Size Put(636192521) Size Size Get Get
+	//    Get Put(926295394) Get Put(-436422950) Put(-709459920) Get Get
+	//    Put(747261714) Get Get Put(767661609) Size Put(163515640) Put(1246022368)
+	//    Size Get Size Put(-731554381) Put(787685976) Get Put(-475358830) Get Size
+	//    Put(1240433711)]
 }
@@ -116,10 +116,23 @@ func Example_buggyCounter() {
 	// When using testing.T you might just use: properties.TestingRun(t)
 	properties.Run(gopter.ConsoleReporter(false))
 	// Output:
-	// ! buggy counter: Falsified after 43 passed tests.
+	// ! buggy counter: Falsified after 48 passed tests.
+	// > Trace of failing property:
+	// 1. INC returned <nil>: TRUE
+	//    state: 0 -> 1
+	// 2. INC returned <nil>: TRUE
+	//    state: 1 -> 2
+	// 3. INC returned <nil>: TRUE
+	//    state: 2 -> 3
+	// 4. INC returned <nil>: TRUE
+	//    state: 3 -> 4
+	// 5. DEC returned <nil>: TRUE
+	//    state: 4 -> 3
+	// 6. GET returned 2: FALSE
+	//    state: 3 -> 3
 	// ARG_0: initialState=0 sequential=[INC INC INC INC DEC GET]
-	// ARG_0_ORIGINAL (8 shrinks): initialState=0 sequential=[RESET GET GET GET
-	//    RESET DEC DEC INC INC RESET RESET DEC INC RESET INC INC GET INC INC DEC
-	//    DEC GET RESET INC INC DEC INC INC INC RESET RESET INC INC GET INC DEC GET
-	//    DEC GET INC RESET INC INC]
+	// ARG_0_ORIGINAL (5 shrinks): initialState=0 sequential=[RESET DEC GET RESET
+	//    INC GET GET DEC DEC INC GET RESET INC INC GET INC INC DEC DEC INC GET DEC
+	//    INC GET DEC GET GET RESET INC DEC DEC INC INC GET INC GET INC DEC INC INC
+	//    INC GET RESET INC INC DEC RESET DEC]
 }
@@ -0,0 +1,159 @@
+package commands_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/commands"
+	"github.com/leanovate/gopter/gen"
+)
+
+var errFull = errors.New("stack is full")
+
+// boundedStack is a stack with a capacity
+type boundedStack struct {
+	elements []int
+	length   int
+	capacity int
+	// bugs
+	fullEarly   bool
+	lengthOnPop bool
+}
+
+func (s *boundedStack) Push(value int) error {
+	if s.length >= s.capacity || (s.fullEarly && s.length >= 3) {
+		return errFull
+	}
+	s.elements = append(s.elements, value)
+	s.length++
+	return nil
+}
+
+func (s *boundedStack) Pop() int {
+	value := s.elements[len(s.elements)-1]
+	s.elements = s.elements[:len(s.elements)-1]
+	if !s.lengthOnPop {
+		s.length--
+	}
+	return value
+}
+
+const stackCapacity = 5
+
+func pushCommand(value int) commands.Command {
+	return &commands.ProtoCommand{
+		Name: fmt.Sprintf("Push(%d)", value),
+		RunWithErrorFunc: func(systemUnderTest commands.SystemUnderTest, env commands.Env) (commands.Result, error) {
+			return nil, systemUnderTest.(*boundedStack).Push(value)
+		},
+		NextStateFunc: func(state commands.State) commands.State {
+			return append(append([]int{}, state.([]int)...), value)
+		},
+		PreConditionFunc: func(state commands.State) bool {
+			return len(state.([]int)) < stackCapacity
+		},
+	}
+}
+
+var popCommand = &commands.ProtoCommand{
+	Name: "Pop",
+	RunFunc: func(systemUnderTest commands.SystemUnderTest) commands.Result {
+		return systemUnderTest.(*boundedStack).Pop()
+	},
+	NextStateFunc: func(state commands.State) commands.State {
+		elements := state.([]int)
+		return elements[:len(elements)-1]
+	},
+	PreConditionFunc: func(state commands.State) bool {
+		return len(state.([]int)) > 0
+	},
+	PostConditionWithPreStateFunc: func(preState, postState commands.State, result commands.Result) *gopter.PropResult {
+		elements := preState.([]int)
+		if result.(int) != elements[len(elements)-1] {
+			return &gopter.PropResult{Status: gopter.PropFalse}
+		}
+		return &gopter.PropResult{Status: gopter.PropTrue}
+	},
+}
+
+func stackCommands(stack func() *boundedStack) commands.Commands {
+	return &commands.ProtoCommands{
+		NewSystemUnderTestFunc: func(initialState commands.State) commands.SystemUnderTest {
+			return stack()
+		},
+		InitialStateGen: gen.Const([]int{}),
+		GenCommandFunc: func(state commands.State) gopter.Gen {
+			return gen.OneGenOf(gen.IntRange(0, 100).Map(pushCommand), gen.Const(popCommand))
+		},
+		InvariantFunc: func(state commands.State, systemUnderTest commands.SystemUnderTest) *gopter.PropResult {
+			if systemUnderTest.(*boundedStack).length != len(state.([]int)) {
+				return &gopter.PropResult{Status: gopter.PropFalse, Labels: []string{"length"}}
+			}
+			return &gopter.PropResult{Status: gopter.PropTrue}
+		},
+	}
+}
+
+func TestExtendedCommands(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+
+	result := commands.Prop(stackCommands(func() *boundedStack {
+		return &boundedStack{capacity: stackCapacity}
+	})).Check(parameters)
+	if !result.Passed() {
+		t.Errorf("Invalid result: %v", result)
+	}
+
+	result = commands.Prop(stackCommands(func() *boundedStack {
+		return &boundedStack{capacity: stackCapacity, fullEarly: true}
+	})).Check(parameters)
+	if result.Status != gopter.TestError || !errors.Is(result.Error, errFull) {
+		t.Fatalf("Invalid result: %v", result)
+	}
+	if shrunk := fmt.Sprint(result.Args[0].Arg); strings.Count(shrunk, "Push(0)") != 4 || strings.Contains(shrunk, "Pop") {
+		t.Errorf("Invalid shrink: %s", shrunk)
+	}
+
+	result = commands.Prop(stackCommands(func() *boundedStack {
+		return &boundedStack{capacity: stackCapacity, lengthOnPop: true}
+	})).Check(parameters)
+	if result.Status != gopter.TestFailed || len(result.Labels) != 1 || result.Labels[0] != "length" {
+		t.Fatalf("Invalid result: %v", result)
+	}
+	if shrunk := fmt.Sprint(result.Args[0].Arg); !strings.HasSuffix(shrunk, "sequential=[Push(0) Pop]") {
+		t.Errorf("Invalid shrink: %s", shrunk)
+	}
+}
+
+func TestCommandsTrace(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+
+	result := commands.Prop(stackCommands(func() *boundedStack {
+		return &boundedStack{capacity: stackCapacity, lengthOnPop: true}
+	})).Check(parameters)
+	if result.Status != gopter.TestFailed || len(result.Trace) != 2 {
+		t.Fatalf("Invalid result: %v", result)
+	}
+	push, pop := result.Trace[0], result.Trace[1]
+	if push.Name != "Push(0)" || push.PreState != "[]" || push.PostState != "[0]" || push.Result != nil ||
+		push.Status != gopter.PropTrue {
+		t.Errorf("Invalid step: %#v", push)
+	}
+	if pop.Name != "Pop" || pop.PreState != "[0]" || pop.PostState != "[]" || pop.Result != 0 ||
+		pop.Status != gopter.PropFalse || len(pop.Labels) != 1 || pop.Labels[0] != "length" {
+		t.Errorf("Invalid step: %#v", pop)
+	}
+
+	result = commands.Prop(stackCommands(func() *boundedStack {
+		return &boundedStack{capacity: stackCapacity, fullEarly: true}
+	})).Check(parameters)
+	if result.Status != gopter.TestError || len(result.Trace) != 4 {
+		t.Fatalf("Invalid result: %v", result)
+	}
+	if last := result.Trace[3]; last.Status != gopter.PropError || len(last.Labels) != 1 || last.Labels[0] != errFull.Error() {
+		t.Errorf("Invalid step: %#v", last)
+	}
+}
@@ -0,0 +1,55 @@
+package commands
+
+import "fmt"
+
+// Var is a symbolic variable referring to the result of a command.
+// As commands are generated before they are run, a later command can not refer to the actual
+// result of an earlier one (e.g. the id of a created entity), instead the expected state may
+// record the symbolic variable of the earlier command (see VarCommand.NextStateWithVar) and the
+// later command refers to it (see VarCommand.Vars). When the commands are run the variables are
+// bound to the actual results (see Env).
+type Var int
+
+func (v Var) String() string {
+	return fmt.Sprintf("$%d", int(v))
+}
+
+// Env binds the symbolic variables to the actual results of the commands run so far
+type Env map[Var]Result
+
+// Lookup gets the result bound to a variable
+func (e Env) Lookup(v Var) (Result, bool) {
+	result, ok := e[v]
+	return result, ok
+}
+
+// VarCommand is an optional extension of the Command interface for commands that record the
+// symbolic variable of their result in the expected state, or refer to the results of earlier
+// commands.
+type VarCommand interface {
+	Command
+	// NextStateWithVar calculates the next expected state if the command is applied, result is
+	// the symbolic variable that will be bound to the result of the command.
+	// It is used instead of NextState.
+	NextStateWithVar(state State, result Var) State
+	// Vars returns the symbolic variables (of earlier commands) the command refers to.
+	// While shrinking a command is dropped if one of its variables is no longer bound.
+	Vars() []Var
+	// RunWithEnv applies the command to the system under test, env contains the results of
+	// the earlier commands. It is used instead of Run.
+	RunWithEnv(systemUnderTest SystemUnderTest, env Env) Result
+}
+
+func nextState(command Command, state State, result Var) State {
+	if varCommand, ok := command.(VarCommand); ok {
+		return varCommand.NextStateWithVar(state, result)
+	}
+	return command.NextState(state)
+}
+
+func commandVars(command Command) []Var {
+	if varCommand, ok := command.(VarCommand); ok {
+		return varCommand.Vars()
+	}
+	return nil
+}
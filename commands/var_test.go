@@ -0,0 +1,150 @@
+package commands_test
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/commands"
+	"github.com/leanovate/gopter/gen"
+)
+
+// idStore creates entries with ids that can not be predicted by the model
+type idStore struct {
+	next    int
+	entries map[int]bool
+	// buggy stores do not delete if there are more than two entries
+	buggy bool
+}
+
+func (s *idStore) Create() int {
+	s.next = s.next*7 + 3
+	s.entries[s.next] = true
+	return s.next
+}
+
+func (s *idStore) Delete(id int) {
+	if s.buggy && len(s.entries) > 2 {
+		return
+	}
+	delete(s.entries, id)
+}
+
+func (s *idStore) Exists(id int) bool {
+	return s.entries[id]
+}
+
+// idState maps the symbolic variables of all created entries to their existence
+type idState map[commands.Var]bool
+
+func (s idState) with(v commands.Var, exists bool) idState {
+	next := make(idState, len(s)+1)
+	for k, e := range s {
+		next[k] = e
+	}
+	next[v] = exists
+	return next
+}
+
+var createCommand = &commands.ProtoCommand{
+	Name: "Create",
+	RunFunc: func(systemUnderTest commands.SystemUnderTest) commands.Result {
+		return systemUnderTest.(*idStore).Create()
+	},
+	NextStateWithVarFunc: func(state commands.State, result commands.Var) commands.State {
+		return state.(idState).with(result, true)
+	},
+}
+
+func deleteCommand(v commands.Var) commands.Command {
+	return &commands.ProtoCommand{
+		Name:     fmt.Sprintf("Delete(%v)", v),
+		UsedVars: []commands.Var{v},
+		RunWithEnvFunc: func(systemUnderTest commands.SystemUnderTest, env commands.Env) commands.Result {
+			id, _ := env.Lookup(v)
+			systemUnderTest.(*idStore).Delete(id.(int))
+			return nil
+		},
+		NextStateFunc: func(state commands.State) commands.State {
+			return state.(idState).with(v, false)
+		},
+		PreConditionFunc: func(state commands.State) bool {
+			return state.(idState)[v]
+		},
+	}
+}
+
+func existsCommand(v commands.Var) commands.Command {
+	return &commands.ProtoCommand{
+		Name:     fmt.Sprintf("Exists(%v)", v),
+		UsedVars: []commands.Var{v},
+		RunWithEnvFunc: func(systemUnderTest commands.SystemUnderTest, env commands.Env) commands.Result {
+			id, _ := env.Lookup(v)
+			return systemUnderTest.(*idStore).Exists(id.(int))
+		},
+		PreConditionFunc: func(state commands.State) bool {
+			_, ok := state.(idState)[v]
+			return ok
+		},
+		PostConditionFunc: func(state commands.State, result commands.Result) *gopter.PropResult {
+			if result.(bool) != state.(idState)[v] {
+				return &gopter.PropResult{Status: gopter.PropFalse}
+			}
+			return &gopter.PropResult{Status: gopter.PropTrue}
+		},
+	}
+}
+
+func idStoreCommands(buggy bool) commands.Commands {
+	return &commands.ProtoCommands{
+		NewSystemUnderTestFunc: func(initialState commands.State) commands.SystemUnderTest {
+			return &idStore{entries: map[int]bool{}, buggy: buggy}
+		},
+		InitialStateGen: gen.Const(idState{}),
+		GenCommandFunc: func(state commands.State) gopter.Gen {
+			vars := make([]int, 0, len(state.(idState)))
+			for v := range state.(idState) {
+				vars = append(vars, int(v))
+			}
+			// sorted for reproducible generation
+			sort.Ints(vars)
+			candidates := []interface{}{createCommand}
+			for _, i := range vars {
+				v := commands.Var(i)
+				candidates = append(candidates, existsCommand(v))
+				if state.(idState)[v] {
+					candidates = append(candidates, deleteCommand(v))
+				}
+			}
+			return gen.OneConstOf(candidates...)
+		},
+	}
+}
+
+func TestSymbolicVars(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+
+	result := commands.Prop(idStoreCommands(false)).Check(parameters)
+	if !result.Passed() {
+		t.Errorf("Invalid result: %v", result)
+	}
+
+	result = commands.Prop(idStoreCommands(true)).Check(parameters)
+	if result.Status != gopter.TestFailed {
+		t.Fatalf("Invalid result: %v", result)
+	}
+	shrunk := fmt.Sprint(result.Args[0].Arg)
+	// every variable a command refers to has to be bound by an earlier command
+	for _, match := range regexp.MustCompile(`\((\$\d+)\)`).FindAllStringSubmatchIndex(shrunk, -1) {
+		v := shrunk[match[2]:match[3]]
+		if !strings.Contains(shrunk[:match[0]], v+"=Create") {
+			t.Errorf("Unbound variable %s in %s", v, shrunk)
+		}
+	}
+	if strings.Count(shrunk, "Create") != 3 {
+		t.Errorf("Invalid shrink: %s", shrunk)
+	}
+}
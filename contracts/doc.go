@@ -0,0 +1,21 @@
+/*
+Package contracts contains suites of properties checking that a type obeys the contract of
+a common interface of the standard library (e.g. sort.Interface, io.Reader or hash.Hash).
+
+Every suite registers its properties on a gopter.Properties, the names of the properties are
+prefixed by the given name. A simple example might look like this:
+
+	func TestMyHash(t *testing.T) {
+	  properties := gopter.NewProperties(nil)
+
+	  contracts.Hash(properties, "MyHash", gen.SliceOf(gen.UInt8()), func() hash.Hash {
+	    return NewMyHash()
+	  })
+
+	  properties.TestingRun(t)
+	}
+
+Suites for types that are modified by the check (e.g. sort.Interface) require the generator to
+create a new value every time, and the generated values are not shrinked.
+*/
+package contracts
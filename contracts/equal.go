@@ -0,0 +1,37 @@
+package contracts
+
+import (
+	"fmt"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// EqualHash registers the properties of the consistency of an equality and a hash function
+// (e.g. the Equal and Hash methods of a type), i.e. equal has to be an equivalence relation and
+// equal values have to have the same hash.
+// As two generated values are rarely equal, every value is compared with itself as well.
+func EqualHash(properties *gopter.Properties, name string, gen gopter.Gen,
+	equal func(a, b interface{}) bool, hash func(interface{}) uint64) {
+	properties.Property(name+": Equal is reflexive", prop.ForAll(func(a interface{}) bool {
+		return equal(a, a)
+	}, gen))
+	properties.Property(name+": Equal is symmetric", prop.ForAll(func(a, b interface{}) bool {
+		return equal(a, b) == equal(b, a)
+	}, gen, gen))
+	properties.Property(name+": Equal is transitive", prop.ForAll(func(a, b, c interface{}) bool {
+		return !equal(a, b) || !equal(b, c) || equal(a, c)
+	}, gen, gen, gen))
+	properties.Property(name+": Hash is deterministic", prop.ForAll(func(a interface{}) string {
+		if first, second := hash(a), hash(a); first != second {
+			return fmt.Sprintf("Hash returned %d and %d", first, second)
+		}
+		return ""
+	}, gen))
+	properties.Property(name+": equal values have the same Hash", prop.ForAll(func(a, b interface{}) string {
+		if equal(a, b) && hash(a) != hash(b) {
+			return fmt.Sprintf("Hash of equal values is %d and %d", hash(a), hash(b))
+		}
+		return ""
+	}, gen, gen))
+}
@@ -0,0 +1,29 @@
+package contracts_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/contracts"
+	"github.com/leanovate/gopter/gen"
+)
+
+func TestEqualHash(t *testing.T) {
+	words := gen.OneConstOf("a", "A", "b", "B", "c")
+	equalFold := func(a, b interface{}) bool {
+		return strings.EqualFold(a.(string), b.(string))
+	}
+
+	properties := gopter.NewProperties(nil)
+	contracts.EqualHash(properties, "case insensitive", words, equalFold, func(a interface{}) uint64 {
+		return uint64(strings.ToLower(a.(string))[0])
+	})
+	checkContract(t, properties)
+
+	properties = gopter.NewProperties(nil)
+	contracts.EqualHash(properties, "case sensitive hash", words, equalFold, func(a interface{}) uint64 {
+		return uint64(a.(string)[0])
+	})
+	checkContract(t, properties, "case sensitive hash: equal values have the same Hash")
+}
@@ -0,0 +1,72 @@
+package contracts
+
+import (
+	"bytes"
+	"fmt"
+	"hash"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// Hash registers the properties of the contract of hash.Hash, i.e. the sum must not depend on how
+// the content is split into writes, Sum must not change the state and Reset has to restore the
+// initial state.
+// contentGen: has to create the content as []byte, newHash creates a new hash.
+func Hash(properties *gopter.Properties, name string, contentGen gopter.Gen, newHash func() hash.Hash) {
+	sum := func(content []byte) []byte {
+		h := newHash()
+		h.Write(content)
+		return h.Sum(nil)
+	}
+
+	properties.Property(name+": Sum has Size bytes", prop.ForAll(func(content []byte) string {
+		h := newHash()
+		if s := sum(content); len(s) != h.Size() {
+			return fmt.Sprintf("Sum has %d bytes instead of %d", len(s), h.Size())
+		}
+		return ""
+	}, contentGen))
+	properties.Property(name+": Write is split invariant", prop.ForAll(func(content []byte, sizes []int) string {
+		h := newHash()
+		if err := writeChunked(h, content, sizes); err != nil {
+			return err.Error()
+		}
+		if s, expected := h.Sum(nil), sum(content); !bytes.Equal(s, expected) {
+			return fmt.Sprintf("Sum of chunked writes is %x instead of %x", s, expected)
+		}
+		return ""
+	}, contentGen, chunkSizes()))
+	properties.Property(name+": Sum appends to the buffer", prop.ForAll(func(content, prefix []byte) string {
+		h := newHash()
+		h.Write(content)
+		s := h.Sum(append([]byte{}, prefix...))
+		if !bytes.HasPrefix(s, prefix) || !bytes.Equal(s[len(prefix):], sum(content)) {
+			return fmt.Sprintf("Sum(%x) is %x", prefix, s)
+		}
+		return ""
+	}, contentGen, contentGen))
+	properties.Property(name+": Sum does not change the state", prop.ForAll(func(content, more []byte) string {
+		h := newHash()
+		h.Write(content)
+		first, second := h.Sum(nil), h.Sum(nil)
+		if !bytes.Equal(first, second) {
+			return fmt.Sprintf("Sum changed from %x to %x", first, second)
+		}
+		h.Write(more)
+		if s, expected := h.Sum(nil), sum(append(append([]byte{}, content...), more...)); !bytes.Equal(s, expected) {
+			return fmt.Sprintf("Sum after Sum and Write is %x instead of %x", s, expected)
+		}
+		return ""
+	}, contentGen, contentGen))
+	properties.Property(name+": Reset restores the initial state", prop.ForAll(func(content, before []byte) string {
+		h := newHash()
+		h.Write(before)
+		h.Reset()
+		h.Write(content)
+		if s, expected := h.Sum(nil), sum(content); !bytes.Equal(s, expected) {
+			return fmt.Sprintf("Sum after Reset is %x instead of %x", s, expected)
+		}
+		return ""
+	}, contentGen, contentGen))
+}
@@ -0,0 +1,50 @@
+package contracts_test
+
+import (
+	"crypto/sha256"
+	"hash"
+	"hash/crc32"
+	"hash/fnv"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/contracts"
+	"github.com/leanovate/gopter/gen"
+)
+
+// xorHash is a hash that forgets to reset its state
+type xorHash struct {
+	sum byte
+}
+
+func (h *xorHash) Write(p []byte) (int, error) {
+	for _, b := range p {
+		h.sum ^= b
+	}
+	return len(p), nil
+}
+
+func (h *xorHash) Sum(b []byte) []byte { return append(b, h.sum) }
+func (h *xorHash) Reset()              {}
+func (h *xorHash) Size() int           { return 1 }
+func (h *xorHash) BlockSize() int      { return 1 }
+
+func TestHash(t *testing.T) {
+	content := gen.SliceOf(gen.UInt8())
+
+	properties := gopter.NewProperties(nil)
+	contracts.Hash(properties, "sha256", content, sha256.New)
+	contracts.Hash(properties, "fnv", content, func() hash.Hash {
+		return fnv.New64a()
+	})
+	contracts.Hash(properties, "crc32", content, func() hash.Hash {
+		return crc32.NewIEEE()
+	})
+	checkContract(t, properties)
+
+	properties = gopter.NewProperties(nil)
+	contracts.Hash(properties, "xorHash", content, func() hash.Hash {
+		return &xorHash{}
+	})
+	checkContract(t, properties, "xorHash: Reset restores the initial state")
+}
@@ -0,0 +1,222 @@
+package contracts
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing/iotest"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+// maxEmptyReads limits the number of consecutive reads returning neither data nor an error
+const maxEmptyReads = 100
+
+// maxEmptyWrites limits the number of consecutive writes consuming neither data nor returning an error
+const maxEmptyWrites = 100
+
+// chunkSizes generates the buffer sizes used to read or write the content in chunks
+func chunkSizes() gopter.Gen {
+	return gen.SliceOf(gen.IntRange(1, 16))
+}
+
+func chunkSize(sizes []int, i int) int {
+	if len(sizes) == 0 {
+		return 1
+	}
+	return sizes[i%len(sizes)]
+}
+
+// readChunked reads all content of r with buffers of the given sizes checking the
+// contract of every Read
+func readChunked(r io.Reader, sizes []int) ([]byte, error) {
+	var content []byte
+	for i, empty := 0, 0; ; i++ {
+		buf := make([]byte, chunkSize(sizes, i))
+		n, err := r.Read(buf)
+		if n < 0 || n > len(buf) {
+			return content, fmt.Errorf("Read returned %d for a buffer of size %d", n, len(buf))
+		}
+		content = append(content, buf[:n]...)
+		if err == io.EOF {
+			return content, nil
+		} else if err != nil {
+			return content, fmt.Errorf("Read failed: %v", err)
+		}
+		if n > 0 {
+			empty = 0
+		} else if empty++; empty > maxEmptyReads {
+			return content, fmt.Errorf("Read returned 0, nil %d times", empty)
+		}
+	}
+}
+
+// Reader registers the properties of the contract of io.Reader, i.e. the reader has to
+// return its content in chunks of arbitrary size followed by io.EOF.
+// contentGen: has to create the content as []byte, newReader creates a reader of the content.
+func Reader(properties *gopter.Properties, name string, contentGen gopter.Gen, newReader func(content []byte) io.Reader) {
+	properties.Property(name+": Read returns the content", prop.ForAll(func(content []byte, sizes []int) string {
+		read, err := readChunked(newReader(content), sizes)
+		if err != nil {
+			return err.Error()
+		}
+		if !bytes.Equal(read, content) {
+			return fmt.Sprintf("Read %q", read)
+		}
+		return ""
+	}, contentGen, chunkSizes()))
+	properties.Property(name+": Read returns io.EOF at the end", prop.ForAll(func(content []byte) string {
+		r := newReader(content)
+		if _, err := readChunked(r, nil); err != nil {
+			return err.Error()
+		}
+		for i := 0; i < 2; i++ {
+			if n, err := r.Read(make([]byte, 1)); n != 0 || err != io.EOF {
+				return fmt.Sprintf("Read at the end returned %d, %v", n, err)
+			}
+		}
+		return ""
+	}, contentGen))
+	properties.Property(name+": passes iotest.TestReader", prop.ForAll(func(content []byte) string {
+		if err := iotest.TestReader(newReader(content), content); err != nil {
+			return err.Error()
+		}
+		return ""
+	}, contentGen))
+}
+
+// Writer registers the properties of the contract of io.Writer, i.e. the writer has to consume
+// the whole buffer (or return an error) and must not retain the buffer.
+// contentGen: has to create the content as []byte, newWriter creates a writer and a function returning
+// the content written so far.
+func Writer(properties *gopter.Properties, name string, contentGen gopter.Gen, newWriter func() (io.Writer, func() []byte)) {
+	properties.Property(name+": Write consumes the buffer", prop.ForAll(func(content []byte, sizes []int) string {
+		w, _ := newWriter()
+		for i := 0; len(content) > 0; i++ {
+			chunk := content[:minInt(chunkSize(sizes, i), len(content))]
+			n, err := w.Write(chunk)
+			if err != nil {
+				return fmt.Sprintf("Write failed: %v", err)
+			}
+			if n != len(chunk) {
+				return fmt.Sprintf("Write returned %d for a buffer of size %d", n, len(chunk))
+			}
+			content = content[n:]
+		}
+		return ""
+	}, contentGen, chunkSizes()))
+	properties.Property(name+": Write is split invariant", prop.ForAll(func(content []byte, sizes []int) string {
+		w, written := newWriter()
+		if err := writeChunked(w, content, sizes); err != nil {
+			return err.Error()
+		}
+		if !bytes.Equal(written(), content) {
+			return fmt.Sprintf("Written %q", written())
+		}
+		return ""
+	}, contentGen, chunkSizes()))
+	properties.Property(name+": Write does not retain the buffer", prop.ForAll(func(content []byte) string {
+		w, written := newWriter()
+		buf := append([]byte{}, content...)
+		if _, err := w.Write(buf); err != nil {
+			return fmt.Sprintf("Write failed: %v", err)
+		}
+		for i := range buf {
+			buf[i] ^= 0xff
+		}
+		if !bytes.Equal(written(), content) {
+			return fmt.Sprintf("Written %q", written())
+		}
+		return ""
+	}, contentGen))
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func writeChunked(w io.Writer, content []byte, sizes []int) error {
+	for i, empty := 0, 0; len(content) > 0; i++ {
+		chunk := content[:minInt(chunkSize(sizes, i), len(content))]
+		n, err := w.Write(chunk)
+		if n < 0 || n > len(chunk) {
+			return fmt.Errorf("Write returned %d for a buffer of size %d", n, len(chunk))
+		}
+		if err != nil {
+			return fmt.Errorf("Write failed: %v", err)
+		}
+		content = content[n:]
+		if n > 0 {
+			empty = 0
+		} else if empty++; empty > maxEmptyWrites {
+			return fmt.Errorf("Write returned 0, nil %d times", empty)
+		}
+	}
+	return nil
+}
+
+// ReadSeeker registers the properties of the contract of io.ReadSeeker, i.e. the properties of
+// io.Reader (see Reader) and Seek has to set the offset of the next Read.
+// contentGen: has to create the content as []byte, newReadSeeker creates a reader of the content.
+func ReadSeeker(properties *gopter.Properties, name string, contentGen gopter.Gen, newReadSeeker func(content []byte) io.ReadSeeker) {
+	Reader(properties, name, contentGen, func(content []byte) io.Reader {
+		return newReadSeeker(content)
+	})
+	properties.Property(name+": Seek returns the new offset", prop.ForAll(func(content []byte, offset uint) string {
+		r := newReadSeeker(content)
+		expected := int64(offset % uint(len(content)+1))
+		for _, seek := range []struct {
+			offset   int64
+			whence   int
+			expected int64
+		}{
+			{expected, io.SeekStart, expected},
+			{0, io.SeekCurrent, expected},
+			{0, io.SeekEnd, int64(len(content))},
+			{-int64(len(content)) + expected, io.SeekCurrent, expected},
+			{expected - int64(len(content)), io.SeekEnd, expected},
+		} {
+			pos, err := r.Seek(seek.offset, seek.whence)
+			if err != nil {
+				return fmt.Sprintf("Seek(%d, %d) failed: %v", seek.offset, seek.whence, err)
+			}
+			if pos != seek.expected {
+				return fmt.Sprintf("Seek(%d, %d) returned %d instead of %d", seek.offset, seek.whence, pos, seek.expected)
+			}
+		}
+		return ""
+	}, contentGen, gen.UInt()))
+	properties.Property(name+": Read after Seek reads from the offset", prop.ForAll(func(content []byte, offset uint, sizes []int) string {
+		r := newReadSeeker(content)
+		start := offset % uint(len(content)+1)
+		if _, err := readChunked(r, sizes); err != nil {
+			return err.Error()
+		}
+		if _, err := r.Seek(int64(start), io.SeekStart); err != nil {
+			return fmt.Sprintf("Seek(%d, io.SeekStart) failed: %v", start, err)
+		}
+		read, err := readChunked(r, sizes)
+		if err != nil {
+			return err.Error()
+		}
+		if !bytes.Equal(read, content[start:]) {
+			return fmt.Sprintf("Read %q after Seek(%d, io.SeekStart)", read, start)
+		}
+		return ""
+	}, contentGen, gen.UInt(), chunkSizes()))
+	properties.Property(name+": Seek to a negative offset fails", prop.ForAll(func(content []byte) string {
+		r := newReadSeeker(content)
+		if _, err := r.Seek(-1, io.SeekStart); err == nil {
+			return "Seek(-1, io.SeekStart) succeeded"
+		}
+		if _, err := r.Seek(-int64(len(content))-1, io.SeekEnd); err == nil {
+			return fmt.Sprintf("Seek(%d, io.SeekEnd) succeeded", -len(content)-1)
+		}
+		return ""
+	}, contentGen))
+}
@@ -0,0 +1,127 @@
+package contracts_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/contracts"
+	"github.com/leanovate/gopter/gen"
+)
+
+// retainingWriter keeps the written buffers instead of copying them
+type retainingWriter struct {
+	chunks [][]byte
+}
+
+func (w *retainingWriter) Write(p []byte) (int, error) {
+	w.chunks = append(w.chunks, p)
+	return len(p), nil
+}
+
+// stallingWriter consumes nothing without returning an error
+type stallingWriter struct{}
+
+func (w stallingWriter) Write(p []byte) (int, error) {
+	return 0, nil
+}
+
+// overcountingWriter reports more bytes than it has been given
+type overcountingWriter struct {
+	bytes.Buffer
+}
+
+func (w *overcountingWriter) Write(p []byte) (int, error) {
+	n, err := w.Buffer.Write(p)
+	return n + 1, err
+}
+
+// offByOneSeeker ignores the offset relative to the end
+type offByOneSeeker struct {
+	*bytes.Reader
+}
+
+func (s offByOneSeeker) Seek(offset int64, whence int) (int64, error) {
+	if whence == io.SeekEnd {
+		return s.Reader.Seek(0, whence)
+	}
+	return s.Reader.Seek(offset, whence)
+}
+
+func TestReader(t *testing.T) {
+	content := gen.SliceOf(gen.UInt8())
+
+	properties := gopter.NewProperties(nil)
+	contracts.Reader(properties, "bytes.Reader", content, func(content []byte) io.Reader {
+		return bytes.NewReader(content)
+	})
+	contracts.Reader(properties, "OneByteReader", content, func(content []byte) io.Reader {
+		return iotest.OneByteReader(bytes.NewReader(content))
+	})
+	checkContract(t, properties)
+
+	properties = gopter.NewProperties(nil)
+	contracts.Reader(properties, "truncating", content, func(content []byte) io.Reader {
+		if len(content) > 3 {
+			content = content[:3]
+		}
+		return bytes.NewReader(content)
+	})
+	checkContract(t, properties, "truncating: Read returns the content", "truncating: passes iotest.TestReader")
+}
+
+func TestWriter(t *testing.T) {
+	content := gen.SliceOf(gen.UInt8())
+
+	properties := gopter.NewProperties(nil)
+	contracts.Writer(properties, "bytes.Buffer", content, func() (io.Writer, func() []byte) {
+		var buf bytes.Buffer
+		return &buf, buf.Bytes
+	})
+	checkContract(t, properties)
+
+	properties = gopter.NewProperties(nil)
+	contracts.Writer(properties, "retaining", content, func() (io.Writer, func() []byte) {
+		w := &retainingWriter{}
+		return w, func() []byte {
+			return bytes.Join(w.chunks, nil)
+		}
+	})
+	checkContract(t, properties, "retaining: Write does not retain the buffer")
+
+	properties = gopter.NewProperties(nil)
+	contracts.Writer(properties, "stalling", gen.SliceOfN(4, gen.UInt8()), func() (io.Writer, func() []byte) {
+		return stallingWriter{}, func() []byte {
+			return nil
+		}
+	})
+	contracts.Writer(properties, "overcounting", gen.SliceOfN(4, gen.UInt8()), func() (io.Writer, func() []byte) {
+		w := &overcountingWriter{}
+		return w, w.Bytes
+	})
+	checkContract(t, properties, "stalling: Write consumes the buffer", "stalling: Write is split invariant",
+		"stalling: Write does not retain the buffer", "overcounting: Write consumes the buffer",
+		"overcounting: Write is split invariant")
+}
+
+func TestReadSeeker(t *testing.T) {
+	content := gen.SliceOf(gen.UInt8())
+
+	properties := gopter.NewProperties(nil)
+	contracts.ReadSeeker(properties, "strings.Reader", gen.AlphaString().Map(func(s string) []byte {
+		return []byte(s)
+	}), func(content []byte) io.ReadSeeker {
+		return strings.NewReader(string(content))
+	})
+	checkContract(t, properties)
+
+	properties = gopter.NewProperties(nil)
+	contracts.ReadSeeker(properties, "offByOne", content, func(content []byte) io.ReadSeeker {
+		return offByOneSeeker{bytes.NewReader(content)}
+	})
+	checkContract(t, properties, "offByOne: Seek returns the new offset", "offByOne: Seek to a negative offset fails",
+		"offByOne: passes iotest.TestReader")
+}
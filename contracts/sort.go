@@ -0,0 +1,192 @@
+package contracts
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// maxElements limits the number of elements of a sort.Interface that are compared with each
+// other, as the laws of an order are checked for all triples of elements
+const maxElements = 20
+
+func checkedLen(data sort.Interface) int {
+	if n := data.Len(); n < maxElements {
+		return n
+	}
+	return maxElements
+}
+
+func incomparable(data sort.Interface, i, j int) bool {
+	return !data.Less(i, j) && !data.Less(j, i)
+}
+
+// SortInterface registers the properties of the contract of sort.Interface, i.e. Less has to be
+// a strict weak order and Swap has to exchange two elements.
+// gen: has to create a new value implementing sort.Interface every time, the value is modified
+// by the checks.
+func SortInterface(properties *gopter.Properties, name string, gen gopter.Gen) {
+	properties.Property(name+": Less is irreflexive", prop.ForAllNoShrink(func(data sort.Interface) string {
+		for i := 0; i < checkedLen(data); i++ {
+			if data.Less(i, i) {
+				return fmt.Sprintf("Less(%d, %d) is true", i, i)
+			}
+		}
+		return ""
+	}, gen))
+	properties.Property(name+": Less is asymmetric", prop.ForAllNoShrink(func(data sort.Interface) string {
+		n := checkedLen(data)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if data.Less(i, j) && data.Less(j, i) {
+					return fmt.Sprintf("Less(%d, %d) and Less(%d, %d) are true", i, j, j, i)
+				}
+			}
+		}
+		return ""
+	}, gen))
+	properties.Property(name+": Less is transitive", prop.ForAllNoShrink(func(data sort.Interface) string {
+		n := checkedLen(data)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				for k := 0; k < n; k++ {
+					if data.Less(i, j) && data.Less(j, k) && !data.Less(i, k) {
+						return fmt.Sprintf("Less(%d, %d) and Less(%d, %d) are true, but not Less(%d, %d)", i, j, j, k, i, k)
+					}
+				}
+			}
+		}
+		return ""
+	}, gen))
+	properties.Property(name+": incomparability is transitive", prop.ForAllNoShrink(func(data sort.Interface) string {
+		n := checkedLen(data)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				for k := 0; k < n; k++ {
+					if incomparable(data, i, j) && incomparable(data, j, k) && !incomparable(data, i, k) {
+						return fmt.Sprintf("%d, %d and %d, %d are incomparable, but not %d, %d", i, j, j, k, i, k)
+					}
+				}
+			}
+		}
+		return ""
+	}, gen))
+	properties.Property(name+": Swap exchanges elements", prop.ForAllNoShrink(func(data sort.Interface) string {
+		length, n := data.Len(), checkedLen(data)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				before := lessMatrix(data, n)
+				data.Swap(i, j)
+				if data.Len() != length {
+					return fmt.Sprintf("Swap(%d, %d) changed Len", i, j)
+				}
+				after := lessMatrix(data, n)
+				for k := 0; k < n; k++ {
+					for l := 0; l < n; l++ {
+						if after[k][l] != before[swapped(k, i, j)][swapped(l, i, j)] {
+							return fmt.Sprintf("Less(%d, %d) is inconsistent after Swap(%d, %d)", k, l, i, j)
+						}
+					}
+				}
+				data.Swap(i, j)
+			}
+		}
+		return ""
+	}, gen))
+	properties.Property(name+": sort.Sort sorts", prop.ForAllNoShrink(func(data sort.Interface) bool {
+		sort.Sort(data)
+		return sort.IsSorted(data)
+	}, gen))
+}
+
+// lessMatrix records Less of all pairs of the first n elements
+func lessMatrix(data sort.Interface, n int) [][]bool {
+	matrix := make([][]bool, n)
+	for i := range matrix {
+		matrix[i] = make([]bool, n)
+		for j := range matrix[i] {
+			matrix[i][j] = data.Less(i, j)
+		}
+	}
+	return matrix
+}
+
+// swapped maps the index k to its index before Swap(i, j)
+func swapped(k, i, j int) int {
+	switch k {
+	case i:
+		return j
+	case j:
+		return i
+	}
+	return k
+}
+
+// heapOrdered returns the index of the first element that is less than its parent, -1 if
+// the heap invariant holds
+func heapOrdered(data heap.Interface) int {
+	for i := 1; i < data.Len(); i++ {
+		if data.Less(i, (i-1)/2) {
+			return i
+		}
+	}
+	return -1
+}
+
+// HeapInterface registers the properties of the contract of heap.Interface, i.e. the properties
+// of sort.Interface (see SortInterface) and the properties of Push and Pop in combination with
+// the functions of container/heap.
+// gen: has to create a new value implementing heap.Interface every time, the value is modified
+// by the checks.
+func HeapInterface(properties *gopter.Properties, name string, gen gopter.Gen) {
+	SortInterface(properties, name, gen)
+	properties.Property(name+": heap.Init establishes the heap invariant", prop.ForAllNoShrink(func(data heap.Interface) string {
+		heap.Init(data)
+		if i := heapOrdered(data); i >= 0 {
+			return fmt.Sprintf("Element %d is less than its parent", i)
+		}
+		return ""
+	}, gen))
+	properties.Property(name+": heap.Pop removes a minimum", prop.ForAllNoShrink(func(data heap.Interface) string {
+		heap.Init(data)
+		for n := data.Len(); n > 0; n-- {
+			for i := 1; i < n; i++ {
+				if data.Less(i, 0) {
+					return fmt.Sprintf("Element %d is less than the minimum", i)
+				}
+			}
+			heap.Pop(data)
+			if data.Len() != n-1 {
+				return fmt.Sprintf("Len after Pop is %d instead of %d", data.Len(), n-1)
+			}
+			if i := heapOrdered(data); i >= 0 {
+				return fmt.Sprintf("Element %d is less than its parent after Pop", i)
+			}
+		}
+		return ""
+	}, gen))
+	properties.Property(name+": heap.Push keeps the heap invariant", prop.ForAllNoShrink(func(data heap.Interface) string {
+		heap.Init(data)
+		n := data.Len()
+		if n == 0 {
+			return ""
+		}
+		popped := make([]interface{}, 0, n)
+		for data.Len() > n/2 {
+			popped = append(popped, heap.Pop(data))
+		}
+		for _, x := range popped {
+			heap.Push(data, x)
+			if i := heapOrdered(data); i >= 0 {
+				return fmt.Sprintf("Element %d is less than its parent after Push", i)
+			}
+		}
+		if data.Len() != n {
+			return fmt.Sprintf("Len after Push is %d instead of %d", data.Len(), n)
+		}
+		return ""
+	}, gen))
+}
@@ -0,0 +1,113 @@
+package contracts_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/contracts"
+	"github.com/leanovate/gopter/gen"
+)
+
+// resultReporter collects the results of all properties by name
+type resultReporter map[string]*gopter.TestResult
+
+func (r resultReporter) ReportTestResult(propName string, result *gopter.TestResult) {
+	r[propName] = result
+}
+
+// checkContract runs the properties and checks that exactly the expected ones fail
+func checkContract(t *testing.T, properties *gopter.Properties, failing ...string) {
+	reporter := resultReporter{}
+	properties.Run(reporter)
+	for _, name := range failing {
+		if result, ok := reporter[name]; !ok || result.Status != gopter.TestFailed {
+			t.Errorf("Property %s has not failed: %#v", name, result)
+		}
+		delete(reporter, name)
+	}
+	for name, result := range reporter {
+		if !result.Passed() {
+			t.Errorf("Property %s has failed: %#v", name, result)
+		}
+	}
+}
+
+// brokenSort is an int slice that is ordered by less or equal
+type brokenSort []int
+
+func (s brokenSort) Len() int           { return len(s) }
+func (s brokenSort) Less(i, j int) bool { return s[i] <= s[j] }
+func (s brokenSort) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// brokenSwap is an int slice that only copies on Swap
+type brokenSwap []int
+
+func (s brokenSwap) Len() int           { return len(s) }
+func (s brokenSwap) Less(i, j int) bool { return s[i] < s[j] }
+func (s brokenSwap) Swap(i, j int)      { s[i] = s[j] }
+
+// intHeap is a min heap of ints
+type intHeap struct {
+	sort.IntSlice
+}
+
+func (h *intHeap) Push(x interface{}) {
+	h.IntSlice = append(h.IntSlice, x.(int))
+}
+
+func (h *intHeap) Pop() interface{} {
+	x := h.IntSlice[len(h.IntSlice)-1]
+	h.IntSlice = h.IntSlice[:len(h.IntSlice)-1]
+	return x
+}
+
+// brokenHeap pops the first instead of the last element
+type brokenHeap struct {
+	intHeap
+}
+
+func (h *brokenHeap) Pop() interface{} {
+	x := h.IntSlice[0]
+	h.IntSlice = h.IntSlice[1:]
+	return x
+}
+
+func TestSortInterface(t *testing.T) {
+	ints := gen.SliceOf(gen.IntRange(0, 10))
+
+	properties := gopter.NewProperties(nil)
+	contracts.SortInterface(properties, "IntSlice", ints.Map(func(xs []int) sort.IntSlice {
+		return sort.IntSlice(xs)
+	}))
+	checkContract(t, properties)
+
+	properties = gopter.NewProperties(nil)
+	contracts.SortInterface(properties, "brokenSort", ints.Map(func(xs []int) brokenSort {
+		return brokenSort(xs)
+	}))
+	checkContract(t, properties, "brokenSort: Less is irreflexive", "brokenSort: Less is asymmetric",
+		"brokenSort: sort.Sort sorts")
+
+	properties = gopter.NewProperties(nil)
+	contracts.SortInterface(properties, "brokenSwap", ints.Map(func(xs []int) brokenSwap {
+		return brokenSwap(xs)
+	}))
+	checkContract(t, properties, "brokenSwap: Swap exchanges elements", "brokenSwap: sort.Sort sorts")
+}
+
+func TestHeapInterface(t *testing.T) {
+	ints := gen.SliceOf(gen.IntRange(0, 100))
+
+	properties := gopter.NewProperties(nil)
+	contracts.HeapInterface(properties, "intHeap", ints.Map(func(xs []int) *intHeap {
+		return &intHeap{IntSlice: xs}
+	}))
+	checkContract(t, properties)
+
+	properties = gopter.NewProperties(nil)
+	contracts.HeapInterface(properties, "brokenHeap", ints.Map(func(xs []int) *brokenHeap {
+		return &brokenHeap{intHeap{IntSlice: xs}}
+	}))
+	checkContract(t, properties, "brokenHeap: heap.Pop removes a minimum", "brokenHeap: heap.Push keeps the heap invariant")
+}
@@ -0,0 +1,42 @@
+package contracts
+
+import (
+	"bytes"
+	"encoding"
+	"fmt"
+	"reflect"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// TextMarshaler registers the properties of the contract of encoding.TextMarshaler and
+// encoding.TextUnmarshaler, i.e. marshalling has to be deterministic and unmarshalling the text
+// has to result in an equal value (see prop.TextMarshalerRoundTrip).
+// gen: has to create values implementing encoding.TextMarshaler (either by value or by pointer).
+func TextMarshaler(properties *gopter.Properties, name string, gen gopter.Gen) {
+	properties.Property(name+": MarshalText is deterministic", prop.ForAll(func(value interface{}) string {
+		marshaler, ok := value.(encoding.TextMarshaler)
+		if !ok && value != nil {
+			ptr := reflect.New(reflect.TypeOf(value))
+			ptr.Elem().Set(reflect.ValueOf(value))
+			marshaler, ok = ptr.Interface().(encoding.TextMarshaler)
+		}
+		if !ok {
+			return fmt.Sprintf("%T does not implement encoding.TextMarshaler", value)
+		}
+		first, err := marshaler.MarshalText()
+		if err != nil {
+			return fmt.Sprintf("MarshalText failed: %v", err)
+		}
+		second, err := marshaler.MarshalText()
+		if err != nil {
+			return fmt.Sprintf("MarshalText failed: %v", err)
+		}
+		if !bytes.Equal(first, second) {
+			return fmt.Sprintf("MarshalText returned %q and %q", first, second)
+		}
+		return ""
+	}, gen))
+	properties.Property(name+": UnmarshalText restores the value", prop.TextMarshalerRoundTrip(gen))
+}
@@ -0,0 +1,28 @@
+package contracts_test
+
+import (
+	"math/big"
+	"net"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/contracts"
+	"github.com/leanovate/gopter/gen"
+)
+
+func TestTextMarshaler(t *testing.T) {
+	properties := gopter.NewProperties(nil)
+	contracts.TextMarshaler(properties, "net.IP", gen.SliceOfN(16, gen.UInt8()).Map(func(b []uint8) net.IP {
+		return net.IP(b)
+	}))
+	contracts.TextMarshaler(properties, "big.Int", gen.Int64().Map(func(n int64) big.Int {
+		return *big.NewInt(n)
+	}))
+	checkContract(t, properties)
+
+	properties = gopter.NewProperties(nil)
+	contracts.TextMarshaler(properties, "net.IPv4", gen.SliceOfN(4, gen.UInt8()).Map(func(b []uint8) net.IP {
+		return net.IP(b)
+	}))
+	checkContract(t, properties, "net.IPv4: UnmarshalText restores the value")
+}
@@ -16,7 +16,7 @@ A simple property test might look like this:
     	properties.Property("squared is equal to value", prop.ForAll(
     		func(v float64) bool {
     			r := math.Sqrt(v)
-    			return math.Abs(r*r-v) < 1e-10*v
+    			return math.Abs(r*r-v) <= 1e-10*v
     		},
     		gen.Float64Range(0, math.MaxFloat64),
     	))
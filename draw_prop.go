@@ -0,0 +1,394 @@
+package gopter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// T is the handle of an imperative property (see DrawProp). Values are drawn from generators
+// while the property is checked, so that generators may depend on values drawn before.
+// T implements testing.TB, i.e. t.Errorf, t.Fatalf and assertion libraries based on testing.TB
+// may be used to falsify the property. Logs and errors of a falsified property are reported as
+// its labels.
+// Like for a testing.T, Draw, FailNow and SkipNow (and the methods calling them) must be called
+// from the goroutine running the check function, they stop the check with runtime.Goexit. Called
+// from another goroutine they only stop that goroutine (after marking the property as falsified
+// or skipped). All other methods may be called from any goroutine.
+// Note: testing.TB can only be implemented by embedding it, the embedded TB is nil and only
+// provides the unexported method of testing.TB, T implements all other methods itself.
+type T struct {
+	testing.TB
+	genParams *GenParameters
+	// replay contains the draws of a previous run, the first fixed ones are replayed with their
+	// value, the others are generated again with the same seed
+	replay    []drawn
+	fixed     int
+	draws     []drawn
+	mutex     sync.Mutex
+	failed    bool
+	skipped   bool
+	discarded bool
+	messages  []string
+	cleanups  []func()
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+// drawn is a value drawn from a generator
+type drawn struct {
+	label  string
+	result *GenResult
+	value  interface{}
+	seed   int64
+}
+
+func newT(genParams *GenParameters, replay []drawn, fixed int) *T {
+	return &T{
+		genParams: genParams,
+		replay:    replay,
+		fixed:     fixed,
+	}
+}
+
+// Draw generates a value with gen, the value is labelled with label for reporting.
+// If the generator does not produce a value (e.g. because of a sieve) the check is discarded.
+func (t *T) Draw(gen Gen, label string) interface{} {
+	idx := len(t.draws)
+	if idx < t.fixed {
+		draw := t.replay[idx]
+		draw.label = label
+		t.draws = append(t.draws, draw)
+		return draw.value
+	}
+	var seed int64
+	if idx < len(t.replay) {
+		seed = t.replay[idx].seed
+	} else {
+		seed = t.genParams.Rng.Int63()
+	}
+	result := gen(t.genParams.CloneWithSeed(seed))
+	value, ok := result.Retrieve()
+	if !ok {
+		t.mutex.Lock()
+		t.discarded = true
+		t.mutex.Unlock()
+		runtime.Goexit()
+	}
+	t.draws = append(t.draws, drawn{label: label, result: result, value: value, seed: seed})
+	return value
+}
+
+// Name returns the name of the property handle
+func (t *T) Name() string {
+	return "gopter.T"
+}
+
+// Helper is a noop for a property handle
+func (t *T) Helper() {}
+
+// Log records a message that is reported if the property is falsified
+func (t *T) Log(args ...interface{}) {
+	t.log(strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+}
+
+// Logf records a formatted message that is reported if the property is falsified
+func (t *T) Logf(format string, args ...interface{}) {
+	t.log(fmt.Sprintf(format, args...))
+}
+
+func (t *T) log(messages ...string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.messages = append(t.messages, messages...)
+}
+
+// Fail marks the property as falsified but continues the check
+func (t *T) Fail() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.failed = true
+}
+
+// FailNow marks the property as falsified and stops the check
+func (t *T) FailNow() {
+	t.Fail()
+	runtime.Goexit()
+}
+
+// Failed checks if the property has been falsified
+func (t *T) Failed() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.failed
+}
+
+// Error is equivalent to Log followed by Fail
+func (t *T) Error(args ...interface{}) {
+	t.Log(args...)
+	t.Fail()
+}
+
+// Errorf is equivalent to Logf followed by Fail
+func (t *T) Errorf(format string, args ...interface{}) {
+	t.Logf(format, args...)
+	t.Fail()
+}
+
+// Fatal is equivalent to Log followed by FailNow
+func (t *T) Fatal(args ...interface{}) {
+	t.Log(args...)
+	t.FailNow()
+}
+
+// Fatalf is equivalent to Logf followed by FailNow
+func (t *T) Fatalf(format string, args ...interface{}) {
+	t.Logf(format, args...)
+	t.FailNow()
+}
+
+// SkipNow stops the check and discards it (i.e. the property is undecided)
+func (t *T) SkipNow() {
+	t.mutex.Lock()
+	t.skipped = true
+	t.mutex.Unlock()
+	runtime.Goexit()
+}
+
+// Skip is equivalent to Log followed by SkipNow
+func (t *T) Skip(args ...interface{}) {
+	t.Log(args...)
+	t.SkipNow()
+}
+
+// Skipf is equivalent to Logf followed by SkipNow
+func (t *T) Skipf(format string, args ...interface{}) {
+	t.Logf(format, args...)
+	t.SkipNow()
+}
+
+// Skipped checks if the check has been skipped
+func (t *T) Skipped() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.skipped
+}
+
+// Cleanup registers a function to be called after the check
+func (t *T) Cleanup(f func()) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.cleanups = append(t.cleanups, f)
+}
+
+// TempDir creates a temporary directory that is removed after the check
+func (t *T) TempDir() string {
+	dir, err := os.MkdirTemp("", "gopter")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	return dir
+}
+
+// Setenv sets an environment variable that is restored after the check
+func (t *T) Setenv(key, value string) {
+	prevValue, ok := os.LookupEnv(key)
+	if err := os.Setenv(key, value); err != nil {
+		t.Fatalf("Setenv: %v", err)
+	}
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, prevValue)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+// Chdir changes the working directory, which is restored after the check
+func (t *T) Chdir(dir string) {
+	prevDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+	t.Cleanup(func() {
+		os.Chdir(prevDir)
+	})
+}
+
+// Context returns a context that is canceled after the check (before the cleanup functions are called)
+func (t *T) Context() context.Context {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.ctx == nil {
+		t.ctx, t.cancel = context.WithCancel(context.Background())
+	}
+	return t.ctx
+}
+
+// Output returns a writer whose lines are recorded like the messages of Log
+func (t *T) Output() io.Writer {
+	return tOutput{t: t}
+}
+
+type tOutput struct {
+	t *T
+}
+
+func (o tOutput) Write(p []byte) (int, error) {
+	o.t.log(strings.Split(strings.TrimSuffix(string(p), "\n"), "\n")...)
+	return len(p), nil
+}
+
+// Attr records an attribute like a message of Log
+func (t *T) Attr(key, value string) {
+	t.Logf("%s: %s", key, value)
+}
+
+// ArtifactDir creates a temporary directory like TempDir, i.e. artifacts are not retained
+func (t *T) ArtifactDir() string {
+	return t.TempDir()
+}
+
+// run invokes the check function (in a goroutine of its own, so that it may be stopped with
+// runtime.Goexit) and converts the outcome to a PropResult
+func (t *T) run(check func(*T)) *PropResult {
+	done := make(chan *PropResult)
+	go func() {
+		var result *PropResult
+		defer func() {
+			done <- result
+		}()
+		defer t.cleanup()
+		defer func() {
+			if r := recover(); r != nil {
+				result = &PropResult{
+					Status: PropError,
+					Error:  fmt.Errorf("Check paniced: %v %s", r, debug.Stack()),
+				}
+				return
+			}
+			t.mutex.Lock()
+			defer t.mutex.Unlock()
+			switch {
+			case t.skipped || t.discarded:
+				result = &PropResult{Status: PropUndecided}
+			case t.failed:
+				result = &PropResult{Status: PropFalse, Labels: t.messages}
+			default:
+				result = &PropResult{Status: PropTrue}
+			}
+		}()
+
+		check(t)
+	}()
+	return <-done
+}
+
+// cleanup cancels the context and calls the cleanup functions in reverse order
+func (t *T) cleanup() {
+	t.mutex.Lock()
+	cancel, cleanups := t.cancel, t.cleanups
+	t.mutex.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		cleanups[i]()
+	}
+}
+
+// args creates the PropArgs of all draws
+func (t *T) args(origValues []interface{}, shrinks []int) []*PropArg {
+	args := make([]*PropArg, len(t.draws))
+	for i, draw := range t.draws {
+		args[i] = &PropArg{
+			Label:   draw.label,
+			Arg:     draw.value,
+			OrigArg: draw.value,
+		}
+		if i < len(origValues) {
+			args[i].OrigArg = origValues[i]
+		}
+		if i < len(shrinks) {
+			args[i].Shrinks = shrinks[i]
+		}
+	}
+	return args
+}
+
+// DrawProp creates a property from an imperative check function drawing its values from
+// generators (see T). The property is falsified if the check fails the T (e.g. with t.Errorf)
+// and discarded if it is skipped.
+// A falsified property is shrinked by replaying the draws with shrinked values: The draws are
+// shrinked one after another, the draws before are replayed with their values, the draws after
+// are generated again with their original seed (i.e. generators depending on a shrinked value
+// create a corresponding value).
+func DrawProp(check func(*T)) Prop {
+	return func(genParams *GenParameters) *PropResult {
+		t := newT(genParams, nil, 0)
+		result := t.run(check)
+		if result.Status != PropFalse && result.Status != PropError {
+			return result.WithArgs(t.args(nil, nil))
+		}
+
+		origValues := make([]interface{}, len(t.draws))
+		for i, draw := range t.draws {
+			origValues[i] = draw.value
+		}
+		shrinks := make([]int, len(t.draws))
+		total := 0
+		// shrinking a later draw may allow to shrink an earlier one, so repeat until there is no progress
+		for progress := true; progress; {
+			progress = false
+			for i := 0; i < len(t.draws) && total < genParams.MaxShrinkCount; i++ {
+				for i < len(t.draws) && total < genParams.MaxShrinkCount {
+					shrinked, shrinkedResult := shrinkDraw(genParams, check, t, i)
+					if shrinked == nil {
+						break
+					}
+					t, result = shrinked, shrinkedResult
+					for len(shrinks) < len(t.draws) {
+						shrinks = append(shrinks, 0)
+					}
+					shrinks[i]++
+					total++
+					progress = true
+				}
+			}
+		}
+		return result.WithArgs(t.args(origValues, shrinks))
+	}
+}
+
+// shrinkDraw tries the shrinked values of the i-th draw, the first run that still fails is returned
+func shrinkDraw(genParams *GenParameters, check func(*T), t *T, i int) (*T, *PropResult) {
+	draw := t.draws[i]
+	if draw.result.Shrinker == nil {
+		return nil, nil
+	}
+	shrink := draw.result.Shrinker(draw.value).Filter(draw.result.Sieve)
+	for value, ok := shrink(); ok; value, ok = shrink() {
+		replay := make([]drawn, len(t.draws))
+		copy(replay, t.draws)
+		replay[i].value = value
+		candidate := newT(genParams, replay, i+1)
+		result := candidate.run(check)
+		if result.Status == PropFalse || result.Status == PropError {
+			return candidate, result
+		}
+	}
+	return nil, nil
+}
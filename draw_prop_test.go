@@ -0,0 +1,187 @@
+package gopter_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+)
+
+var _ testing.TB = &gopter.T{}
+
+func TestDrawProp(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	result := gopter.DrawProp(func(t *gopter.T) {
+		n := t.Draw(gen.IntRange(1, 10), "n").(int)
+		xs := t.Draw(gen.SliceOfN(n, gen.Int()), "xs").([]int)
+		if len(xs) != n {
+			t.Fatalf("invalid length: %d != %d", len(xs), n)
+		}
+	}).Check(parameters)
+	if !result.Passed() {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	result = gopter.DrawProp(func(t *gopter.T) {
+		n := t.Draw(gen.IntRange(1, 10), "n").(int)
+		xs := t.Draw(gen.SliceOfN(n, gen.IntRange(0, 1000)), "xs").([]int)
+		for _, x := range xs {
+			if x > 100 {
+				t.Errorf("too large: %d", x)
+			}
+		}
+	}).Check(parameters)
+	if result.Status != gopter.TestFailed || len(result.Args) != 2 {
+		t.Fatalf("Invalid result: %#v", result)
+	}
+	if result.Args[0].Label != "n" || result.Args[1].Label != "xs" {
+		t.Errorf("Invalid labels of args: %#v", result.Args)
+	}
+	// xs is generated again when n is shrinked, so n might not be shrinked to 1
+	n, xs := result.Args[0].Arg.(int), result.Args[1].Arg.([]int)
+	sum := 0
+	for _, x := range xs {
+		sum += x
+	}
+	if len(xs) != n || sum != 101 {
+		t.Errorf("xs not shrinked: %#v", result.Args[1])
+	}
+	if len(result.Labels) != 1 || result.Labels[0] != "too large: 101" {
+		t.Errorf("Invalid labels: %#v", result.Labels)
+	}
+}
+
+func TestDrawPropStatus(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	result := gopter.DrawProp(func(t *gopter.T) {
+		t.Draw(gen.Int().SuchThat(func(int) bool { return false }), "never")
+	}).Check(parameters)
+	if result.Status != gopter.TestExhausted {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	result = gopter.DrawProp(func(t *gopter.T) {
+		t.Skip("skipped")
+	}).Check(parameters)
+	if result.Status != gopter.TestExhausted {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	cleanups := 0
+	result = gopter.DrawProp(func(t *gopter.T) {
+		t.Cleanup(func() {
+			cleanups++
+		})
+		if t.Draw(gen.Bool(), "b").(bool) {
+			panic("Ouchy")
+		}
+	}).Check(parameters)
+	if result.Status != gopter.TestError || result.Error == nil || len(result.Args) != 1 || result.Args[0].Arg != true {
+		t.Errorf("Invalid result: %#v", result)
+	}
+	if cleanups == 0 {
+		t.Error("Cleanup not called")
+	}
+}
+
+func TestDrawPropTB(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	wd, _ := os.Getwd()
+	var ctx context.Context
+	result := gopter.DrawProp(func(t *gopter.T) {
+		ctx = t.Context()
+		if ctx.Err() != nil {
+			t.Fatal("Context canceled during check")
+		}
+		t.Chdir(t.TempDir())
+		t.Attr("key", "value")
+		fmt.Fprintf(t.Output(), "line %d\nline %d\n", 1, 2)
+		if t.ArtifactDir() == "" {
+			t.Fatal("No artifact dir")
+		}
+		t.Fail()
+	}).Check(parameters)
+	if result.Status != gopter.TestFailed || !reflect.DeepEqual(result.Labels, []string{"key: value", "line 1", "line 2"}) {
+		t.Errorf("Invalid result: %#v", result)
+	}
+	if ctx == nil || ctx.Err() == nil {
+		t.Error("Context not canceled after check")
+	}
+	if current, _ := os.Getwd(); current != wd {
+		t.Errorf("Working directory not restored: %s", current)
+	}
+}
+
+func TestDrawPropGoroutines(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	result := gopter.DrawProp(func(t *gopter.T) {
+		n := t.Draw(gen.IntRange(0, 10), "n").(int)
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			t.Logf("n is %d", n)
+		}()
+		go func() {
+			defer wg.Done()
+			if n > 5 {
+				t.Fatal("too large")
+			}
+		}()
+		wg.Wait()
+	}).Check(parameters)
+	if result.Status != gopter.TestFailed || len(result.Args) != 1 || result.Args[0].Arg != 6 {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	result = gopter.DrawProp(func(t *gopter.T) {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			t.SkipNow()
+		}()
+		<-done
+	}).Check(parameters)
+	if result.Status != gopter.TestExhausted {
+		t.Errorf("Invalid result: %#v", result)
+	}
+}
+
+func TestDrawPropTBMethods(t *testing.T) {
+	// calls every method of testing.TB, so that methods added by later Go versions
+	// (i.e. methods of the nil TB embedded by T) are detected
+	tbType := reflect.TypeOf((*testing.TB)(nil)).Elem()
+	parameters := gopter.DefaultTestParameters()
+	for i := 0; i < tbType.NumMethod(); i++ {
+		method := tbType.Method(i)
+		if method.PkgPath != "" {
+			continue
+		}
+		result := gopter.DrawProp(func(t *gopter.T) {
+			methodType := method.Type
+			args := make([]reflect.Value, methodType.NumIn())
+			for j := range args {
+				if methodType.In(j).Kind() == reflect.Func {
+					args[j] = reflect.MakeFunc(methodType.In(j), func([]reflect.Value) []reflect.Value {
+						return nil
+					})
+				} else {
+					args[j] = reflect.Zero(methodType.In(j))
+				}
+			}
+			if methodType.IsVariadic() {
+				reflect.ValueOf(t).MethodByName(method.Name).CallSlice(args)
+			} else {
+				reflect.ValueOf(t).MethodByName(method.Name).Call(args)
+			}
+		}).Check(parameters)
+		if result.Status == gopter.TestError {
+			t.Errorf("%s is not implemented by gopter.T: %v", method.Name, result.Error)
+		}
+	}
+}
@@ -53,7 +53,7 @@ func Example_labels() {
 	// ! Check spooky: Falsified after 0 passed tests.
 	// > Labels of failing property: even result
 	// a: 3
-	// a_ORIGINAL (44 shrinks): 861384713
+	// a_ORIGINAL (45 shrinks): 652880985
 	// b: 0
-	// b_ORIGINAL (1 shrinks): -642623569
+	// b_ORIGINAL (1 shrinks): -590574693
 }
@@ -24,7 +24,7 @@ func Example_sqrt() {
 	properties.Property("squared is equal to value", prop.ForAll(
 		func(v float64) bool {
 			r := math.Sqrt(v)
-			return math.Abs(r*r-v) < 1e-10*v
+			return math.Abs(r*r-v) <= 1e-10*v
 		},
 		gen.Float64().SuchThat(func(x float64) bool { return x >= 0.0 }),
 	))
@@ -51,11 +51,13 @@ func (r *FormatedReporter) reportResult(result *TestResult) string {
 	case TestPassed:
 		status = fmt.Sprintf("OK, passed %d tests.", result.Succeeded)
 	case TestFailed:
-		status = fmt.Sprintf("Falsified after %d passed tests.\n%s%s", result.Succeeded, r.reportLabels(result.Labels), r.reportPropArgs(result.Args))
+		status = fmt.Sprintf("Falsified after %d passed tests.\n%s%s%s", result.Succeeded, r.reportLabels(result.Labels),
+			r.reportTrace(result.Trace), r.reportPropArgs(result.Args))
 	case TestExhausted:
 		status = fmt.Sprintf("Gave up after only %d passed tests. %d tests were discarded.", result.Succeeded, result.Discarded)
 	case TestError:
-		status = fmt.Sprintf("Error on property evaluation after %d passed tests: %s\n%s", result.Succeeded, result.Error.Error(), r.reportPropArgs(result.Args))
+		status = fmt.Sprintf("Error on property evaluation after %d passed tests: %s\n%s%s", result.Succeeded, result.Error.Error(),
+			r.reportTrace(result.Trace), r.reportPropArgs(result.Args))
 	}
 
 	if r.verbose {
@@ -71,6 +73,21 @@ func (r *FormatedReporter) reportLabels(labels []string) string {
 	return ""
 }
 
+func (r *FormatedReporter) reportTrace(trace []*TraceStep) string {
+	if len(trace) == 0 {
+		return ""
+	}
+	result := "> Trace of failing property:\n"
+	for i, step := range trace {
+		result += fmt.Sprintf("%d. %s returned %v: %s", i+1, step.Name, step.Result, step.Status)
+		if len(step.Labels) > 0 {
+			result += fmt.Sprintf(" (%s)", strings.Join(step.Labels, ", "))
+		}
+		result += fmt.Sprintf("\n   state: %s -> %s\n", step.PreState, step.PostState)
+	}
+	return result
+}
+
 func (r *FormatedReporter) reportPropArgs(p PropArgs) string {
 	result := ""
 	for i, arg := range p {
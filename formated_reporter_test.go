@@ -71,6 +71,24 @@ func TestConsoleReporter(t *testing.T) {
 	}
 	buffer.Reset()
 
+	reporter.ReportTestResult("test property", &TestResult{
+		Status:    TestFailed,
+		Succeeded: 50,
+		Trace: []*TraceStep{
+			&TraceStep{Name: "Inc", PreState: "0", PostState: "1", Status: PropTrue},
+			&TraceStep{Name: "Get", PreState: "1", PostState: "1", Result: 2, Status: PropFalse, Labels: []string{"expected 1"}},
+		},
+		Args: PropArgs([]*PropArg{&PropArg{
+			Arg: "[Inc Get]",
+		}}),
+	})
+	if buffer.String() != "! test property: Falsified after 50 passed tests.\n> Trace of failing property:\n"+
+		"1. Inc returned <nil>: TRUE\n   state: 0 -> 1\n2. Get returned 2: FALSE (expected 1)\n   state: 1 -> 1\n"+
+		"ARG_0: [Inc Get]\n" {
+		t.Errorf("Invalid output: %#v", buffer.String())
+	}
+	buffer.Reset()
+
 	reporter.verbose = true
 	reporter.ReportTestResult("test property", &TestResult{Status: TestPassed, Succeeded: 50, Time: time.Minute})
 	if buffer.String() != "+ test property: OK, passed 50 tests.\nElapsed time: 1m0s\n" {
@@ -3,6 +3,7 @@ package gopter
 import (
 	"fmt"
 	"reflect"
+	"sync"
 )
 
 // Gen generator of arbitrary values.
@@ -98,11 +99,30 @@ func (g Gen) WithShrinker(shrinker Shrinker) Gen {
 	}
 }
 
+// WithEdgeCases creates a derived generator that mixes in edge cases (i.e. boundary values).
+// Every time a value is generated one of the edge cases is used instead with the
+// probability defined by GenParameters.EdgeCaseProbability.
+// Note: The edge cases have to match the result type of the generator, the sieve and shrinker
+// of the generator are kept.
+func (g Gen) WithEdgeCases(edgeCases ...interface{}) Gen {
+	return func(genParams *GenParameters) *GenResult {
+		idx, ok := genParams.NextEdgeCase(len(edgeCases))
+		result := g(genParams)
+		if ok {
+			result.Result = edgeCases[idx]
+		}
+		return result
+	}
+}
+
 // Map creates a derived generators by mapping all generatored values with a given function.
 // f: has to be a function with one parameter (matching the generated value) and a single return.
-// Note: The derived generator will not have a sieve or shrinker.
+// Note: The derived generator keeps the sieve and shrinker of the original generator, i.e. values
+// are shrinked in the domain of the original generator and the shrinked values are mapped again.
+// Therefore the mapping function is supposed to be deterministic.
 // Note: The mapping function may have a second parameter "*GenParameters"
-// Note: The first parameter of the mapping function and its return may be a *GenResult (this makes MapResult obsolete)
+// Note: The first parameter of the mapping function and its return may be a *GenResult (this makes MapResult obsolete),
+// in this case the mapping function is responsible for the sieve and shrinker.
 func (g Gen) Map(f interface{}) Gen {
 	mapperVal := reflect.ValueOf(f)
 	mapperType := mapperVal.Type()
@@ -135,6 +155,7 @@ func (g Gen) Map(f interface{}) Gen {
 		genResultOutput = true
 	}
 
+	values := newMappedValues(mapperType)
 	return func(genParams *GenParameters) *GenResult {
 		result := g(genParams)
 		if genResultInput {
@@ -156,21 +177,22 @@ func (g Gen) Map(f interface{}) Gen {
 		}
 		value, ok := result.RetrieveAsValue()
 		if ok {
-			var mapped reflect.Value
+			mapper := func(value reflect.Value) reflect.Value {
+				return mapperVal.Call([]reflect.Value{value})[0]
+			}
 			if needsGenParameters {
-				mapped = mapperVal.Call([]reflect.Value{value, reflect.ValueOf(genParams)})[0]
-			} else {
-				mapped = mapperVal.Call([]reflect.Value{value})[0]
+				// shrinked values have to be mapped with the same random numbers as the
+				// original value, so the mapper gets parameters cloned from a fixed seed
+				seed := genParams.NextInt64()
+				mapper = func(value reflect.Value) reflect.Value {
+					return mapperVal.Call([]reflect.Value{value, reflect.ValueOf(genParams.CloneWithSeed(seed))})[0]
+				}
 			}
+			mapped := mapper(value)
 			if genResultOutput {
 				return mapped.Interface().(*GenResult)
 			}
-			return &GenResult{
-				Shrinker:   NoShrinker,
-				Result:     mapped.Interface(),
-				Labels:     result.Labels,
-				ResultType: mapperType.Out(0),
-			}
+			return values.result(&mappedSource{result: result, value: value.Interface(), mapper: mapper}, mapped.Interface())
 		}
 		return &GenResult{
 			Shrinker:   NoShrinker,
@@ -181,14 +203,138 @@ func (g Gen) Map(f interface{}) Gen {
 	}
 }
 
+// maxMappedValues limits the number of mapped values remembered by a generation of mappedValues
+const maxMappedValues = 1 << 12
+
+// mappedValues remembers the source of the values mapped by a generator (including the shrinked
+// ones), so that mapped values can be shrinked in the domain of the original generator. The table
+// is shared by all results of the generator, i.e. a mapped value is shrinked independent of the
+// result it stems from (e.g. the elements of a SliceOf). Only the most recently used values are
+// remembered: Once the current generation is full it replaces the previous one.
+type mappedValues struct {
+	mapperType reflect.Type
+	mutex      sync.Mutex
+	current    map[interface{}]*mappedSource
+	previous   map[interface{}]*mappedSource
+}
+
+// mappedSource is the source of a mapped value
+type mappedSource struct {
+	result *GenResult
+	value  interface{}
+	mapper func(reflect.Value) reflect.Value
+}
+
+func newMappedValues(mapperType reflect.Type) *mappedValues {
+	return &mappedValues{
+		mapperType: mapperType,
+		current:    map[interface{}]*mappedSource{},
+	}
+}
+
+func (m *mappedValues) result(source *mappedSource, mapped interface{}) *GenResult {
+	m.record(mapped, source)
+	result := &GenResult{
+		Shrinker:   m.shrink,
+		Result:     mapped,
+		Labels:     source.result.Labels,
+		ResultType: m.mapperType.Out(0),
+	}
+	if source.result.Sieve != nil {
+		result.Sieve = m.sieve
+	}
+	return result
+}
+
+func (m *mappedValues) record(mapped interface{}, source *mappedSource) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.put(mappedKey(mapped), source)
+}
+
+func (m *mappedValues) put(key interface{}, source *mappedSource) {
+	if len(m.current) >= maxMappedValues {
+		m.previous, m.current = m.current, map[interface{}]*mappedSource{}
+	}
+	m.current[key] = source
+}
+
+func (m *mappedValues) lookup(mapped interface{}) (*mappedSource, bool) {
+	key := mappedKey(mapped)
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if source, ok := m.current[key]; ok {
+		return source, true
+	}
+	if source, ok := m.previous[key]; ok {
+		m.put(key, source)
+		return source, true
+	}
+	return nil, false
+}
+
+// reprKey is the key of a value that can not be used as map key itself
+type reprKey string
+
+// mappedKey creates a map key for a value. Values that are not comparable or not equal
+// to themselves (e.g. slices, NaN or funcs) are represented by their formatted value.
+func mappedKey(v interface{}) (key interface{}) {
+	if v == nil {
+		return nil
+	}
+	if reflect.TypeOf(v).Comparable() {
+		defer func() {
+			// comparable types may contain interfaces with non-comparable values
+			if recover() != nil {
+				key = reprKey(fmt.Sprintf("%T %#v", v, v))
+			}
+		}()
+		if equalInterfaces(v, v) {
+			return v
+		}
+	}
+	return reprKey(fmt.Sprintf("%T %#v", v, v))
+}
+
+func equalInterfaces(a, b interface{}) bool {
+	return a == b
+}
+
+func (m *mappedValues) sieve(v interface{}) bool {
+	source, ok := m.lookup(v)
+	return !ok || source.result.Sieve == nil || source.result.Sieve(source.value)
+}
+
+func (m *mappedValues) shrink(v interface{}) Shrink {
+	source, ok := m.lookup(v)
+	if !ok || source.result.Shrinker == nil {
+		return NoShrink
+	}
+	shrink := source.result.Shrinker(source.value).Filter(source.result.Sieve)
+	return func() (interface{}, bool) {
+		value, ok := shrink()
+		if !ok {
+			return nil, false
+		}
+		originalValue := reflect.ValueOf(value)
+		if value == nil {
+			originalValue = reflect.Zero(m.mapperType.In(0))
+		}
+		mapped := source.mapper(originalValue).Interface()
+		m.record(mapped, &mappedSource{result: source.result, value: value, mapper: source.mapper})
+		return mapped, true
+	}
+}
+
 // FlatMap creates a derived generator by passing a generated value to a function which itself
 // creates a generator.
+// The created generator uses an independent stream of random numbers (see GenParameters.Split).
 func (g Gen) FlatMap(f func(interface{}) Gen, resultType reflect.Type) Gen {
 	return func(genParams *GenParameters) *GenResult {
 		result := g(genParams)
 		value, ok := result.Retrieve()
 		if ok {
-			return f(value)(genParams)
+			return f(value)(genParams.Split())
 		}
 		return &GenResult{
 			Shrinker:   NoShrinker,
@@ -0,0 +1,187 @@
+package gen
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/leanovate/gopter"
+)
+
+// Bytes generates an arbitrary byte slice
+// genParams.MaxSize sets an (exclusive) upper limit on the length of the slice
+// genParams.MinSize sets an (inclusive) lower limit on the length of the slice
+func Bytes() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		return gopter.NewGenResult(genBytes(genParams, genLen(genParams)), BytesShrinker)
+	}
+}
+
+// BytesN generates an arbitrary byte slice with a defined length
+func BytesN(desiredlen int) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		genResult := gopter.NewGenResult(genBytes(genParams, desiredlen), BytesShrinkerOne)
+		genResult.Sieve = func(v interface{}) bool {
+			return len(v.([]byte)) == desiredlen
+		}
+		return genResult
+	}
+}
+
+// BytesWithPrefix generates an arbitrary byte slice starting with a given prefix.
+// This is useful for binary formats that start with some kind of magic number.
+// Only the bytes following the prefix will be shrinked.
+func BytesWithPrefix(prefix []byte) gopter.Gen {
+	prefix = append([]byte{}, prefix...)
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		value := append(append([]byte{}, prefix...), genBytes(genParams, genLen(genParams))...)
+		genResult := gopter.NewGenResult(value, func(v interface{}) gopter.Shrink {
+			return BytesShrinker(v.([]byte)[len(prefix):]).Map(func(suffix []byte) []byte {
+				return append(append([]byte{}, prefix...), suffix...)
+			})
+		})
+		genResult.Sieve = func(v interface{}) bool {
+			return bytes.HasPrefix(v.([]byte), prefix)
+		}
+		return genResult
+	}
+}
+
+// Runes generates an arbitrary rune slice
+// genParams.MaxSize sets an (exclusive) upper limit on the length of the slice
+// genParams.MinSize sets an (inclusive) lower limit on the length of the slice
+// Contrary to SliceOf(Rune()) the slice is shrinked like a byte slice (see RunesShrinker).
+func Runes() gopter.Gen {
+	runeGen := Rune()
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		return gopter.NewGenResult(genRunes(runeGen, genParams, genLen(genParams)), RunesShrinker)
+	}
+}
+
+// RunesN generates an arbitrary rune slice with a defined length
+func RunesN(desiredlen int) gopter.Gen {
+	runeGen := Rune()
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		genResult := gopter.NewGenResult(genRunes(runeGen, genParams, desiredlen), RunesShrinkerOne)
+		genResult.Sieve = func(v interface{}) bool {
+			return len(v.([]rune)) == desiredlen
+		}
+		return genResult
+	}
+}
+
+// BinaryMutator mutates binary data for the Binary generators.
+// A mutator must not modify its input (or the corpus), instead it has to return
+// a mutated copy. All random decisions have to be based on genParams.Rng.
+type BinaryMutator func(data []byte, corpus [][]byte, genParams *gopter.GenParameters) []byte
+
+// DefaultBinaryMutators are the mutators used by Binary
+var DefaultBinaryMutators = []BinaryMutator{
+	FlipBitMutator,
+	MagicValueMutator,
+	SpliceMutator,
+	TruncateMutator,
+}
+
+// Binary generates binary blobs by mutating a seed corpus (e.g. a collection of valid
+// files for a parser) with the DefaultBinaryMutators.
+// If the corpus is empty, arbitrary bytes are used as seeds.
+func Binary(corpus ...[]byte) gopter.Gen {
+	return BinaryWithMutators(DefaultBinaryMutators, corpus...)
+}
+
+// BinaryWithMutators generates binary blobs by mutating a seed corpus with a given
+// list of mutators.
+// Every value is created by applying between 1 and 1 + genParams.MaxSize / 16 randomly
+// chosen mutations to a randomly chosen entry of the corpus. The unmodified entries of the
+// corpus are used as edge cases.
+func BinaryWithMutators(mutators []BinaryMutator, corpus ...[]byte) gopter.Gen {
+	if len(mutators) == 0 {
+		return Fail(reflect.TypeOf([]byte{}))
+	}
+	seeds := make([][]byte, len(corpus))
+	for i, entry := range corpus {
+		seeds[i] = append([]byte{}, entry...)
+	}
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		if idx, ok := genParams.NextEdgeCase(len(seeds)); ok {
+			return gopter.NewGenResult(append([]byte{}, seeds[idx]...), BytesShrinker)
+		}
+		var data []byte
+		if len(seeds) == 0 {
+			data = genBytes(genParams, genLen(genParams))
+		} else {
+			data = seeds[genParams.Rng.Intn(len(seeds))]
+		}
+		mutations := 1 + genParams.Rng.Intn(1+genParams.MaxSize/16)
+		for i := 0; i < mutations; i++ {
+			data = mutators[genParams.Rng.Intn(len(mutators))](data, seeds, genParams)
+		}
+		return gopter.NewGenResult(append([]byte{}, data...), BytesShrinker)
+	}
+}
+
+// FlipBitMutator flips a random bit
+func FlipBitMutator(data []byte, corpus [][]byte, genParams *gopter.GenParameters) []byte {
+	if len(data) == 0 {
+		return data
+	}
+	result := append([]byte{}, data...)
+	bit := genParams.Rng.Intn(len(result) * 8)
+	result[bit/8] ^= 1 << uint(bit%8)
+	return result
+}
+
+var magicValues = [][]byte{
+	{0x00}, {0x01}, {0x7f}, {0x80}, {0xff},
+	{0x00, 0x00}, {0x7f, 0xff}, {0xff, 0x7f}, {0x80, 0x00}, {0x00, 0x80}, {0xff, 0xff},
+	{0x00, 0x00, 0x00, 0x00}, {0x7f, 0xff, 0xff, 0xff}, {0xff, 0xff, 0xff, 0x7f},
+	{0x80, 0x00, 0x00, 0x00}, {0x00, 0x00, 0x00, 0x80}, {0xff, 0xff, 0xff, 0xff},
+}
+
+// MagicValueMutator overwrites the data at a random position with a "magic" value
+// (i.e. 0, 1, -1 or the min/max values of signed 8, 16 and 32 bit integers in either
+// byte order)
+func MagicValueMutator(data []byte, corpus [][]byte, genParams *gopter.GenParameters) []byte {
+	magic := magicValues[genParams.Rng.Intn(len(magicValues))]
+	if len(data) < len(magic) {
+		return append([]byte{}, magic...)
+	}
+	result := append([]byte{}, data...)
+	copy(result[genParams.Rng.Intn(len(result)-len(magic)+1):], magic)
+	return result
+}
+
+// SpliceMutator combines the head of the data with the tail of a random entry of the corpus
+// (or the data itself if the corpus is empty)
+func SpliceMutator(data []byte, corpus [][]byte, genParams *gopter.GenParameters) []byte {
+	other := data
+	if len(corpus) > 0 {
+		other = corpus[genParams.Rng.Intn(len(corpus))]
+	}
+	head := genParams.Rng.Intn(len(data) + 1)
+	tail := genParams.Rng.Intn(len(other) + 1)
+	return append(append(make([]byte, 0, head+len(other)-tail), data[:head]...), other[tail:]...)
+}
+
+// TruncateMutator cuts the data at a random position
+func TruncateMutator(data []byte, corpus [][]byte, genParams *gopter.GenParameters) []byte {
+	return append([]byte{}, data[:genParams.Rng.Intn(len(data)+1)]...)
+}
+
+func genBytes(genParams *gopter.GenParameters, desiredlen int) []byte {
+	result := make([]byte, desiredlen)
+	for i := range result {
+		result[i] = byte(genParams.Rng.Intn(256))
+	}
+	return result
+}
+
+func genRunes(runeGen gopter.Gen, genParams *gopter.GenParameters, desiredlen int) []rune {
+	result := make([]rune, 0, desiredlen)
+	for len(result) < desiredlen {
+		if value, ok := runeGen(genParams).Retrieve(); ok {
+			result = append(result, value.(rune))
+		}
+	}
+	return result
+}
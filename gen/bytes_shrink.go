@@ -0,0 +1,138 @@
+package gen
+
+import "github.com/leanovate/gopter"
+
+type chunkShrink struct {
+	length      int
+	offset      int
+	chunkLength int
+	// shrinkChunk either removes or zeroes the chunk [start, end) of the original,
+	// it returns false if there is nothing to shrink
+	shrinkChunk func(start, end int) (interface{}, bool)
+}
+
+// Next either removes or zeroes chunks of the original slice.
+// The chunk length starts with the whole length and is halved every time the
+// end of the original is reached.
+func (s *chunkShrink) Next() (interface{}, bool) {
+	for s.chunkLength > 0 {
+		if s.offset >= s.length {
+			s.offset = 0
+			s.chunkLength >>= 1
+			continue
+		}
+		start := s.offset
+		end := start + s.chunkLength
+		if end > s.length {
+			end = s.length
+		}
+		s.offset = end
+		if value, ok := s.shrinkChunk(start, end); ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+func removeBytes(original []byte) *chunkShrink {
+	return &chunkShrink{
+		length:      len(original),
+		chunkLength: len(original),
+		shrinkChunk: func(start, end int) (interface{}, bool) {
+			value := make([]byte, 0, len(original)-end+start)
+			return append(append(value, original[:start]...), original[end:]...), true
+		},
+	}
+}
+
+func zeroBytes(original []byte) *chunkShrink {
+	return &chunkShrink{
+		length:      len(original),
+		chunkLength: len(original),
+		shrinkChunk: func(start, end int) (interface{}, bool) {
+			if isZeroBytes(original[start:end]) {
+				return nil, false
+			}
+			value := make([]byte, len(original))
+			copy(value, original)
+			for i := start; i < end; i++ {
+				value[i] = 0
+			}
+			return value, true
+		},
+	}
+}
+
+func isZeroBytes(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// BytesShrinker is a shrinker for byte slices.
+// It first removes chunks of decreasing length (starting with the whole slice, then
+// halves, quarters, ...) and then zeroes chunks of decreasing length.
+func BytesShrinker(v interface{}) gopter.Shrink {
+	original := v.([]byte)
+	return gopter.ConcatShrinks(removeBytes(original).Next, zeroBytes(original).Next)
+}
+
+// BytesShrinkerOne is a shrinker for byte slices that keeps the length of the slice
+// unchanged, instead chunks of decreasing length are zeroed.
+func BytesShrinkerOne(v interface{}) gopter.Shrink {
+	return zeroBytes(v.([]byte)).Next
+}
+
+func removeRunes(original []rune) *chunkShrink {
+	return &chunkShrink{
+		length:      len(original),
+		chunkLength: len(original),
+		shrinkChunk: func(start, end int) (interface{}, bool) {
+			value := make([]rune, 0, len(original)-end+start)
+			return append(append(value, original[:start]...), original[end:]...), true
+		},
+	}
+}
+
+func zeroRunes(original []rune) *chunkShrink {
+	return &chunkShrink{
+		length:      len(original),
+		chunkLength: len(original),
+		shrinkChunk: func(start, end int) (interface{}, bool) {
+			if isZeroRunes(original[start:end]) {
+				return nil, false
+			}
+			value := make([]rune, len(original))
+			copy(value, original)
+			for i := start; i < end; i++ {
+				value[i] = 0
+			}
+			return value, true
+		},
+	}
+}
+
+func isZeroRunes(r []rune) bool {
+	for _, v := range r {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// RunesShrinker is a shrinker for rune slices like BytesShrinker, i.e. it first removes
+// chunks of decreasing length and then zeroes chunks of decreasing length.
+func RunesShrinker(v interface{}) gopter.Shrink {
+	original := v.([]rune)
+	return gopter.ConcatShrinks(removeRunes(original).Next, zeroRunes(original).Next)
+}
+
+// RunesShrinkerOne is a shrinker for rune slices that keeps the length of the slice
+// unchanged, instead chunks of decreasing length are zeroed.
+func RunesShrinkerOne(v interface{}) gopter.Shrink {
+	return zeroRunes(v.([]rune)).Next
+}
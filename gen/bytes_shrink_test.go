@@ -0,0 +1,76 @@
+package gen_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/leanovate/gopter/gen"
+)
+
+func TestBytesShrinker(t *testing.T) {
+	emptyShrink := gen.BytesShrinker([]byte{}).All()
+	if len(emptyShrink) != 0 {
+		t.Errorf("Invalid emptyShrink: %#v", emptyShrink)
+	}
+
+	oneShrink := gen.BytesShrinker([]byte{1}).All()
+	if !reflect.DeepEqual(oneShrink, []interface{}{
+		[]byte{},
+		[]byte{0},
+	}) {
+		t.Errorf("Invalid oneShrink: %#v", oneShrink)
+	}
+
+	threeShrink := gen.BytesShrinker([]byte{1, 0, 3}).All()
+	if !reflect.DeepEqual(threeShrink, []interface{}{
+		[]byte{},
+		[]byte{0, 3},
+		[]byte{1, 3},
+		[]byte{1, 0},
+		[]byte{0, 0, 0},
+		[]byte{0, 0, 3},
+		[]byte{1, 0, 0},
+	}) {
+		t.Errorf("Invalid threeShrink: %#v", threeShrink)
+	}
+}
+
+func TestBytesShrinkerOne(t *testing.T) {
+	zeroShrink := gen.BytesShrinkerOne([]byte{0, 0}).All()
+	if len(zeroShrink) != 0 {
+		t.Errorf("Invalid zeroShrink: %#v", zeroShrink)
+	}
+
+	twoShrink := gen.BytesShrinkerOne([]byte{1, 2}).All()
+	if !reflect.DeepEqual(twoShrink, []interface{}{
+		[]byte{0, 0},
+		[]byte{0, 2},
+		[]byte{1, 0},
+	}) {
+		t.Errorf("Invalid twoShrink: %#v", twoShrink)
+	}
+}
+
+func TestRunesShrinker(t *testing.T) {
+	threeShrink := gen.RunesShrinker([]rune("a\x00€")).All()
+	if !reflect.DeepEqual(threeShrink, []interface{}{
+		[]rune{},
+		[]rune("\x00€"),
+		[]rune("a€"),
+		[]rune("a\x00"),
+		[]rune{0, 0, 0},
+		[]rune("\x00\x00€"),
+		[]rune("a\x00\x00"),
+	}) {
+		t.Errorf("Invalid threeShrink: %#v", threeShrink)
+	}
+
+	twoShrink := gen.RunesShrinkerOne([]rune("ab")).All()
+	if !reflect.DeepEqual(twoShrink, []interface{}{
+		[]rune{0, 0},
+		[]rune("\x00b"),
+		[]rune("a\x00"),
+	}) {
+		t.Errorf("Invalid twoShrink: %#v", twoShrink)
+	}
+}
@@ -0,0 +1,118 @@
+package gen_test
+
+import (
+	"bytes"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+)
+
+func TestBytes(t *testing.T) {
+	commonGeneratorTest(t, "bytes", gen.Bytes(), func(value interface{}) bool {
+		v, ok := value.([]byte)
+		return ok && len(v) < 100
+	})
+
+	commonGeneratorTest(t, "bytes n", gen.BytesN(17), func(value interface{}) bool {
+		v, ok := value.([]byte)
+		return ok && len(v) == 17
+	})
+
+	prefix := []byte{0x89, 'P', 'N', 'G'}
+	commonGeneratorTest(t, "bytes with prefix", gen.BytesWithPrefix(prefix), func(value interface{}) bool {
+		v, ok := value.([]byte)
+		return ok && bytes.HasPrefix(v, prefix)
+	})
+}
+
+func TestRunes(t *testing.T) {
+	validRunes := func(value interface{}) bool {
+		runes, ok := value.([]rune)
+		if !ok {
+			return false
+		}
+		for _, ch := range runes {
+			if !utf8.ValidRune(ch) {
+				return false
+			}
+		}
+		return true
+	}
+	commonGeneratorTest(t, "runes", gen.Runes(), func(value interface{}) bool {
+		return validRunes(value) && len(value.([]rune)) < 100
+	})
+	commonGeneratorTest(t, "runes n", gen.RunesN(17), func(value interface{}) bool {
+		return validRunes(value) && len(value.([]rune)) == 17
+	})
+}
+
+func TestBinary(t *testing.T) {
+	corpus := [][]byte{
+		[]byte("GIF89a"),
+		[]byte("{\"key\": [1, 2, 3]}"),
+	}
+	commonGeneratorTest(t, "binary", gen.Binary(corpus...), func(value interface{}) bool {
+		_, ok := value.([]byte)
+		return ok
+	})
+	commonGeneratorTest(t, "binary without corpus", gen.Binary(), func(value interface{}) bool {
+		_, ok := value.([]byte)
+		return ok
+	})
+	if string(corpus[0]) != "GIF89a" || string(corpus[1]) != "{\"key\": [1, 2, 3]}" {
+		t.Errorf("Corpus was modified: %#v", corpus)
+	}
+
+	parameters := gopter.DefaultGenParameters()
+	parameters.EdgeCaseProbability = 1
+	binary := gen.Binary(corpus...)
+	for i := 0; i < 10; i++ {
+		value, ok := binary(parameters).Retrieve()
+		if !ok || (!bytes.Equal(value.([]byte), corpus[0]) && !bytes.Equal(value.([]byte), corpus[1])) {
+			t.Errorf("Invalid edge case: %#v", value)
+		}
+	}
+
+	if _, ok := gen.BinaryWithMutators(nil, corpus...).Sample(); ok {
+		t.Error("Binary without mutators should fail")
+	}
+}
+
+func TestBinaryMutators(t *testing.T) {
+	parameters := gopter.DefaultGenParameters()
+	data := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	corpus := [][]byte{{9, 10, 11}}
+
+	for i := 0; i < 100; i++ {
+		flipped := gen.FlipBitMutator(data, corpus, parameters)
+		diff := 0
+		for j := range data {
+			for x := data[j] ^ flipped[j]; x != 0; x &= x - 1 {
+				diff++
+			}
+		}
+		if len(flipped) != len(data) || diff != 1 {
+			t.Errorf("Invalid bit flip: %#v", flipped)
+		}
+
+		magic := gen.MagicValueMutator(data, corpus, parameters)
+		if len(magic) != len(data) {
+			t.Errorf("Invalid magic value: %#v", magic)
+		}
+
+		truncated := gen.TruncateMutator(data, corpus, parameters)
+		if !bytes.HasPrefix(data, truncated) {
+			t.Errorf("Invalid truncation: %#v", truncated)
+		}
+
+		spliced := gen.SpliceMutator(data, corpus, parameters)
+		if len(spliced) > len(data)+len(corpus[0]) {
+			t.Errorf("Invalid splice: %#v", spliced)
+		}
+	}
+	if !bytes.Equal(data, []byte{1, 2, 3, 4, 5, 6, 7, 8}) || !bytes.Equal(corpus[0], []byte{9, 10, 11}) {
+		t.Errorf("Mutators modified their input: %#v %#v", data, corpus)
+	}
+}
@@ -12,7 +12,7 @@ func Complex128Box(min, max complex128) gopter.Gen {
 	}).SuchThat(func(v complex128) bool {
 		return real(v) >= real(min) && real(v) <= real(max) &&
 			imag(v) >= imag(min) && imag(v) <= imag(max)
-	}).WithShrinker(Complex128Shrinker)
+	}).WithShrinker(complex128Shrinker(Float64RangeShrinker(real(min), real(max)), Float64RangeShrinker(imag(min), imag(max))))
 }
 
 // Complex128 generate arbitrary complex128 numbers
@@ -35,7 +35,7 @@ func Complex64Box(min, max complex64) gopter.Gen {
 	}).SuchThat(func(v complex64) bool {
 		return real(v) >= real(min) && real(v) <= real(max) &&
 			imag(v) >= imag(min) && imag(v) <= imag(max)
-	}).WithShrinker(Complex64Shrinker)
+	}).WithShrinker(complex64Shrinker(Float32RangeShrinker(real(min), real(max)), Float32RangeShrinker(imag(min), imag(max))))
 }
 
 // Complex64 generate arbitrary complex64 numbers
@@ -15,7 +15,7 @@ func TestComplex128Box(t *testing.T) {
 	complexs := gen.Complex128Box(complex(minReal, minImag), complex(maxReal, maxImag))
 	commonGeneratorTest(t, "complex 128 box", complexs, func(value interface{}) bool {
 		v, ok := value.(complex128)
-		return ok && real(v) >= minReal && real(v) < maxReal && imag(v) >= minImag && imag(v) < maxImag
+		return ok && real(v) >= minReal && real(v) <= maxReal && imag(v) >= minImag && imag(v) <= maxImag
 	})
 }
 
@@ -34,7 +34,7 @@ func TestComplex64Box(t *testing.T) {
 	complexs := gen.Complex64Box(complex(minReal, minImag), complex(maxReal, maxImag))
 	commonGeneratorTest(t, "complex 64 box", complexs, func(value interface{}) bool {
 		v, ok := value.(complex64)
-		return ok && real(v) >= minReal && real(v) < maxReal && imag(v) >= minImag && imag(v) < maxImag
+		return ok && real(v) >= minReal && real(v) <= maxReal && imag(v) >= minImag && imag(v) <= maxImag
 	})
 }
 
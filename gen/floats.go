@@ -7,6 +7,18 @@ import (
 	"github.com/leanovate/gopter"
 )
 
+var float64EdgeCases = []interface{}{
+	float64(0), math.Copysign(0, -1), float64(1), float64(-1),
+	math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64,
+	math.MaxFloat64, -math.MaxFloat64,
+}
+
+var float32EdgeCases = []interface{}{
+	float32(0), float32(math.Copysign(0, -1)), float32(1), float32(-1),
+	float32(math.SmallestNonzeroFloat32), float32(-math.SmallestNonzeroFloat32),
+	float32(math.MaxFloat32), float32(-math.MaxFloat32),
+}
+
 // Float64Range generates float64 numbers within a given range
 func Float64Range(min, max float64) gopter.Gen {
 	d := max - min
@@ -14,13 +26,18 @@ func Float64Range(min, max float64) gopter.Gen {
 		return Fail(reflect.TypeOf(float64(0)))
 	}
 
-	return func(genParams *gopter.GenParameters) *gopter.GenResult {
-		genResult := gopter.NewGenResult(min+genParams.Rng.Float64()*d, Float64Shrinker)
+	edgeCases := []interface{}{min, max}
+	if min < 0 && max > 0 {
+		edgeCases = append(edgeCases, float64(0))
+	}
+
+	return gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+		genResult := gopter.NewGenResult(min+genParams.Rng.Float64()*d, Float64RangeShrinker(min, max))
 		genResult.Sieve = func(v interface{}) bool {
 			return v.(float64) >= min && v.(float64) <= max
 		}
 		return genResult
-	}
+	}).WithEdgeCases(edgeCases...)
 }
 
 // Float64 generates arbitrary float64 numbers that do not contain NaN or Inf
@@ -35,7 +52,32 @@ func Float64() gopter.Gen {
 		mantissa := uint64(values[2].(int64))
 
 		return math.Float64frombits((sign << 63) | (exponent << 52) | mantissa)
-	}).WithShrinker(Float64Shrinker)
+	}).WithShrinker(Float64Shrinker).WithEdgeCases(float64EdgeCases...)
+}
+
+// AnyFloat64 generates arbitrary float64 numbers including NaN and +/-Inf
+func AnyFloat64() gopter.Gen {
+	return gopter.CombineGens(
+		Int64Range(0, 1),
+		Int64Range(0, 0x7ff),
+		Int64Range(0, 0xfffffffffffff),
+	).Map(func(values []interface{}) float64 {
+		sign := uint64(values[0].(int64))
+		exponent := uint64(values[1].(int64))
+		mantissa := uint64(values[2].(int64))
+
+		return math.Float64frombits((sign << 63) | (exponent << 52) | mantissa)
+	}).WithShrinker(Float64Shrinker).WithEdgeCases(append([]interface{}{
+		math.NaN(), math.Inf(1), math.Inf(-1),
+	}, float64EdgeCases...)...)
+}
+
+// SizedFloat64 generates float64 numbers whose magnitude is bounded by the size parameter,
+// i.e. numbers within [-genParams.MaxSize, genParams.MaxSize]
+func SizedFloat64() gopter.Gen {
+	return Sized(func(size int) gopter.Gen {
+		return Float64Range(-float64(size), float64(size))
+	})
 }
 
 // Float32Range generates float32 numbers within a given range
@@ -44,13 +86,18 @@ func Float32Range(min, max float32) gopter.Gen {
 	if d < 0 || d > math.MaxFloat32 {
 		return Fail(reflect.TypeOf(float32(0)))
 	}
-	return func(genParams *gopter.GenParameters) *gopter.GenResult {
-		genResult := gopter.NewGenResult(min+genParams.Rng.Float32()*d, Float32Shrinker)
+	edgeCases := []interface{}{min, max}
+	if min < 0 && max > 0 {
+		edgeCases = append(edgeCases, float32(0))
+	}
+
+	return gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+		genResult := gopter.NewGenResult(min+genParams.Rng.Float32()*d, Float32RangeShrinker(min, max))
 		genResult.Sieve = func(v interface{}) bool {
 			return v.(float32) >= min && v.(float32) <= max
 		}
 		return genResult
-	}
+	}).WithEdgeCases(edgeCases...)
 }
 
 // Float32 generates arbitrary float32 numbers that do not contain NaN or Inf
@@ -65,5 +112,30 @@ func Float32() gopter.Gen {
 		mantissa := uint32(values[2].(int32))
 
 		return math.Float32frombits((sign << 31) | (exponent << 23) | mantissa)
-	}).WithShrinker(Float32Shrinker)
+	}).WithShrinker(Float32Shrinker).WithEdgeCases(float32EdgeCases...)
+}
+
+// AnyFloat32 generates arbitrary float32 numbers including NaN and +/-Inf
+func AnyFloat32() gopter.Gen {
+	return gopter.CombineGens(
+		Int32Range(0, 1),
+		Int32Range(0, 0xff),
+		Int32Range(0, 0x7fffff),
+	).Map(func(values []interface{}) float32 {
+		sign := uint32(values[0].(int32))
+		exponent := uint32(values[1].(int32))
+		mantissa := uint32(values[2].(int32))
+
+		return math.Float32frombits((sign << 31) | (exponent << 23) | mantissa)
+	}).WithShrinker(Float32Shrinker).WithEdgeCases(append([]interface{}{
+		float32(math.NaN()), float32(math.Inf(1)), float32(math.Inf(-1)),
+	}, float32EdgeCases...)...)
+}
+
+// SizedFloat32 generates float32 numbers whose magnitude is bounded by the size parameter,
+// i.e. numbers within [-genParams.MaxSize, genParams.MaxSize]
+func SizedFloat32() gopter.Gen {
+	return Sized(func(size int) gopter.Gen {
+		return Float32Range(-float32(size), float32(size))
+	})
 }
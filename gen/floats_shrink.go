@@ -2,48 +2,158 @@ package gen
 
 import (
 	"math"
+	"strconv"
+	"strings"
 
 	"github.com/leanovate/gopter"
 )
 
-type float64Shrink struct {
-	original float64
-	half     float64
+// maxFloatHalvings limits the number of candidates created by halving the distance to the target
+const maxFloatHalvings = 64
+
+// floatShrinker shrinks floats within [min, max] with a precision of bitSize (32 or 64).
+// Simpler floats have a smaller distance to the target (i.e. 0 or the bound of the range
+// closest to 0), then fewer significant digits and positive floats are simpler than
+// negative ones (including -0). The candidates are tried in this order: the target,
+// integral (truncated and rounded) values, values rounded and truncated to fewer
+// significant digits, the absolute value and finally values halving the distance to the
+// target. Only the simpler ones are offered, i.e. a float never shrinks away from the target.
+// NaN and +/-Inf are shrinked to the target (and +/-MaxFloat for +/-Inf).
+type floatShrinker struct {
+	min, max float64
+	bitSize  int
+}
+
+func (s floatShrinker) round(f float64) float64 {
+	if s.bitSize == 32 {
+		return float64(float32(f))
+	}
+	return f
+}
+
+func (s floatShrinker) target() float64 {
+	if s.min > 0 {
+		return s.min
+	} else if s.max < 0 {
+		return s.max
+	}
+	return 0
 }
 
-func (s *float64Shrink) isZeroOrVeryClose() bool {
-	if s.half == 0 {
+// digits counts the significant (decimal) digits of the shortest representation of a float
+func (s floatShrinker) digits(f float64) int {
+	mantissa := strconv.FormatFloat(f, 'e', -1, s.bitSize)
+	mantissa = mantissa[:strings.IndexByte(mantissa, 'e')]
+	return len(strings.TrimLeft(strings.Replace(mantissa, ".", "", 1), "-"))
+}
+
+// truncate cuts a float to the given number of significant digits (i.e. rounds toward 0)
+func (s floatShrinker) truncate(f float64, digits int) (float64, error) {
+	formatted := strconv.FormatFloat(f, 'e', -1, s.bitSize)
+	exponent := strings.IndexByte(formatted, 'e')
+	mantissa := formatted[:exponent]
+	// the mantissa has the form [-]d.ddd, i.e. the first digit precedes the point
+	if end := strings.IndexByte(mantissa, '.') + digits; end < len(mantissa) {
+		mantissa = mantissa[:end]
+	}
+	return strconv.ParseFloat(mantissa+formatted[exponent:], s.bitSize)
+}
+
+// simpler checks if a is simpler than b
+func (s floatShrinker) simpler(a, b float64) bool {
+	if math.IsNaN(b) || math.IsInf(b, 0) {
 		return true
 	}
-	muliple := s.half * 100000
-	return math.Abs(muliple) < 1 && muliple != 0
+	target := s.target()
+	if da, db := math.Abs(a-target), math.Abs(b-target); da != db {
+		return da < db
+	}
+	if da, db := s.digits(a), s.digits(b); da != db {
+		return da < db
+	}
+	return !math.Signbit(a) && math.Signbit(b)
 }
 
-func (s *float64Shrink) Next() (interface{}, bool) {
-	if s.isZeroOrVeryClose() {
-		return nil, false
+func (s floatShrinker) candidates(v float64) []float64 {
+	target := s.target()
+	if math.IsNaN(v) {
+		return []float64{target}
 	}
-	value := s.original - s.half
-	s.half /= 2
-	return value, true
+	if math.IsInf(v, 0) {
+		maxFloat := math.MaxFloat64
+		if s.bitSize == 32 {
+			maxFloat = math.MaxFloat32
+		}
+		return []float64{target, math.Copysign(maxFloat, v)}
+	}
+	candidates := []float64{target, math.Trunc(v), math.Round(v)}
+	for digits := 1; digits < s.digits(v); digits++ {
+		rounded, err := strconv.ParseFloat(strconv.FormatFloat(v, 'e', digits-1, s.bitSize), s.bitSize)
+		if err == nil {
+			candidates = append(candidates, rounded)
+		}
+		if truncated, err := s.truncate(v, digits); err == nil {
+			candidates = append(candidates, truncated)
+		}
+	}
+	candidates = append(candidates, math.Abs(v))
+	distance := v - target
+	if math.Abs(distance) >= 1 {
+		distance = math.Trunc(distance)
+	}
+	for half, i := distance/2, 0; half != 0 && i < maxFloatHalvings; half, i = half/2, i+1 {
+		if math.Abs(distance) >= 1 {
+			half = math.Trunc(half)
+		}
+		candidates = append(candidates, target+distance-half)
+	}
+	return candidates
+}
+
+func (s floatShrinker) shrink(v float64) gopter.Shrink {
+	values := []interface{}{}
+	seen := map[float64]bool{}
+	for _, candidate := range s.candidates(v) {
+		candidate = s.round(candidate)
+		if seen[candidate] || math.IsNaN(candidate) || candidate < s.min || candidate > s.max || !s.simpler(candidate, v) {
+			continue
+		}
+		seen[candidate] = true
+		values = append(values, candidate)
+	}
+	return shrinkValues(values)
 }
 
 // Float64Shrinker is a shrinker for float64 numbers
+// Values are shrinked toward 0, trying integral values and values with fewer
+// significant digits first. NaN and +/-Inf are shrinked to 0 (and +/-MaxFloat64 for +/-Inf).
 func Float64Shrinker(v interface{}) gopter.Shrink {
-	negShrink := float64Shrink{
-		original: -v.(float64),
-		half:     -v.(float64),
-	}
-	posShrink := float64Shrink{
-		original: v.(float64),
-		half:     v.(float64) / 2,
+	return floatShrinker{min: math.Inf(-1), max: math.Inf(1), bitSize: 64}.shrink(v.(float64))
+}
+
+// Float64RangeShrinker creates a shrinker for float64 numbers within [min, max]
+// (like Float64Shrinker, but toward the bound of the range closest to 0 if the
+// range does not contain 0)
+func Float64RangeShrinker(min, max float64) gopter.Shrinker {
+	return func(v interface{}) gopter.Shrink {
+		return floatShrinker{min: min, max: max, bitSize: 64}.shrink(v.(float64))
 	}
-	return gopter.Shrink(negShrink.Next).Interleave(gopter.Shrink(posShrink.Next))
 }
 
 // Float32Shrinker is a shrinker for float32 numbers
+// Values are shrinked toward 0, trying integral values and values with fewer
+// significant digits first. NaN and +/-Inf are shrinked to 0 (and +/-MaxFloat32 for +/-Inf).
 func Float32Shrinker(v interface{}) gopter.Shrink {
-	return Float64Shrinker(float64(v.(float32))).Map(func(e float64) float32 {
-		return float32(e)
-	})
+	return Float32RangeShrinker(float32(math.Inf(-1)), float32(math.Inf(1)))(v)
+}
+
+// Float32RangeShrinker creates a shrinker for float32 numbers within [min, max]
+// (like Float32Shrinker, but toward the bound of the range closest to 0 if the
+// range does not contain 0)
+func Float32RangeShrinker(min, max float32) gopter.Shrinker {
+	return func(v interface{}) gopter.Shrink {
+		return floatShrinker{min: float64(min), max: float64(max), bitSize: 32}.shrink(float64(v.(float32))).Map(func(f float64) float32 {
+			return float32(f)
+		})
+	}
 }
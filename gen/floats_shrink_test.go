@@ -1,6 +1,7 @@
 package gen_test
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
@@ -14,96 +15,78 @@ func TestFloat64Shrinker(t *testing.T) {
 	}
 
 	oneShrinks := gen.Float64Shrinker(float64(1)).All()
-	if !reflect.DeepEqual(oneShrinks, []interface{}{
-		0.0,
-		0.5,
-		-0.5,
-		0.75,
-		-0.75,
-		0.875,
-		-0.875,
-		0.9375,
-		-0.9375,
-		0.96875,
-		-0.96875,
-		0.984375,
-		-0.984375,
-		0.9921875,
-		-0.9921875,
-		0.99609375,
-		-0.99609375,
-		0.998046875,
-		-0.998046875,
-		0.9990234375,
-		-0.9990234375,
-		0.99951171875,
-		-0.99951171875,
-		0.999755859375,
-		-0.999755859375,
-		0.9998779296875,
-		-0.9998779296875,
-		0.99993896484375,
-		-0.99993896484375,
-		0.999969482421875,
-		-0.999969482421875,
-		0.9999847412109375,
-		-0.9999847412109375,
-	}) {
-		t.Errorf("Invalid tenShrinks: %#v", oneShrinks)
+	if !reflect.DeepEqual(oneShrinks, []interface{}{0.0}) {
+		t.Errorf("Invalid oneShrinks: %#v", oneShrinks)
 	}
 
 	hundretShrinks := gen.Float64Shrinker(float64(100)).All()
-	if !reflect.DeepEqual(hundretShrinks, []interface{}{
-		0.0,
-		50.0,
-		-50.0,
-		75.0,
-		-75.0,
-		87.5,
-		-87.5,
-		93.75,
-		-93.75,
-		96.875,
-		-96.875,
-		98.4375,
-		-98.4375,
-		99.21875,
-		-99.21875,
-		99.609375,
-		-99.609375,
-		99.8046875,
-		-99.8046875,
-		99.90234375,
-		-99.90234375,
-		99.951171875,
-		-99.951171875,
-		99.9755859375,
-		-99.9755859375,
-		99.98779296875,
-		-99.98779296875,
-		99.993896484375,
-		-99.993896484375,
-		99.9969482421875,
-		-99.9969482421875,
-		99.99847412109375,
-		-99.99847412109375,
-		99.99923706054688,
-		-99.99923706054688,
-		99.99961853027344,
-		-99.99961853027344,
-		99.99980926513672,
-		-99.99980926513672,
-		99.99990463256836,
-		-99.99990463256836,
-		99.99995231628418,
-		-99.99995231628418,
-		99.99997615814209,
-		-99.99997615814209,
-		99.99998807907104,
-		-99.99998807907104,
-	}) {
+	if !reflect.DeepEqual(hundretShrinks, []interface{}{0.0, 50.0, 75.0, 88.0, 94.0, 97.0, 99.0}) {
 		t.Errorf("Invalid hundretShrinks: %#v", hundretShrinks)
 	}
+
+	decimalShrinks := gen.Float64Shrinker(1234.5678).All()
+	if !reflect.DeepEqual(decimalShrinks, []interface{}{
+		0.0, 1234.0, 1000.0, 1200.0, 1230.0, 1234.5, 1234.56, 1234.567,
+		617.0, 926.0, 1080.0, 1157.0, 1196.0, 1215.0, 1225.0, 1232.0, 1233.0,
+	}) {
+		t.Errorf("Invalid decimalShrinks: %#v", decimalShrinks)
+	}
+
+	negativeShrinks := gen.Float64Shrinker(-0.75).All()
+	if len(negativeShrinks) <= 4 || !reflect.DeepEqual(negativeShrinks[:4], []interface{}{0.0, -0.7, 0.75, -0.375}) {
+		t.Errorf("Invalid negativeShrinks: %#v", negativeShrinks)
+	}
+
+	nanShrinks := gen.Float64Shrinker(math.NaN()).All()
+	if !reflect.DeepEqual(nanShrinks, []interface{}{0.0}) {
+		t.Errorf("Invalid nanShrinks: %#v", nanShrinks)
+	}
+
+	infShrinks := gen.Float64Shrinker(math.Inf(-1)).All()
+	if !reflect.DeepEqual(infShrinks, []interface{}{0.0, -math.MaxFloat64}) {
+		t.Errorf("Invalid infShrinks: %#v", infShrinks)
+	}
+
+	for _, v := range []float64{math.SmallestNonzeroFloat64, math.MaxFloat64, -1e-300, 0.1} {
+		count := 0
+		for shrink := gen.Float64Shrinker(v); count < 10000; count++ {
+			next, ok := shrink()
+			if !ok {
+				break
+			}
+			shrink = gen.Float64Shrinker(next)
+		}
+		if count >= 10000 {
+			t.Errorf("Shrinking of %v does not terminate", v)
+		}
+	}
+}
+
+func TestFloat64ShrinkerTowardBound(t *testing.T) {
+	// shrink 0.5 like a property failing for all x > 0.3
+	value := 0.5
+	for shrink := gen.Float64Shrinker(value); ; {
+		next, ok := shrink()
+		if !ok {
+			break
+		}
+		if next.(float64) > 0.3 {
+			value = next.(float64)
+			shrink = gen.Float64Shrinker(value)
+		}
+	}
+	if value <= 0.3 || value > 0.3+1e-9 {
+		t.Errorf("0.5 not shrunk toward 0.3: %v", value)
+	}
+}
+
+func TestFloat64RangeShrinker(t *testing.T) {
+	rangeShrinks := gen.Float64RangeShrinker(100, 200)(123.456).All()
+	if !reflect.DeepEqual(rangeShrinks, []interface{}{
+		100.0, 123.0, 120.0, 123.4, 123.45, 112.0, 118.0, 121.0, 122.0,
+	}) {
+		t.Errorf("Invalid rangeShrinks: %#v", rangeShrinks)
+	}
 }
 
 func TestFloat32Shrinker(t *testing.T) {
@@ -112,43 +95,15 @@ func TestFloat32Shrinker(t *testing.T) {
 		t.Errorf("Invalid zeroShrinks: %#v", zeroShrinks)
 	}
 
-	oneShrinks := gen.Float32Shrinker(float32(1)).All()
-	if !reflect.DeepEqual(oneShrinks, []interface{}{
-		float32(0),
-		float32(0.5),
-		float32(-0.5),
-		float32(0.75),
-		float32(-0.75),
-		float32(0.875),
-		float32(-0.875),
-		float32(0.9375),
-		float32(-0.9375),
-		float32(0.96875),
-		float32(-0.96875),
-		float32(0.984375),
-		float32(-0.984375),
-		float32(0.9921875),
-		float32(-0.9921875),
-		float32(0.99609375),
-		float32(-0.99609375),
-		float32(0.9980469),
-		float32(-0.9980469),
-		float32(0.99902344),
-		float32(-0.99902344),
-		float32(0.9995117),
-		float32(-0.9995117),
-		float32(0.99975586),
-		float32(-0.99975586),
-		float32(0.9998779),
-		float32(-0.9998779),
-		float32(0.99993896),
-		float32(-0.99993896),
-		float32(0.9999695),
-		float32(-0.9999695),
-		float32(0.99998474),
-		float32(-0.99998474),
+	decimalShrinks := gen.Float32Shrinker(float32(12.375)).All()
+	if !reflect.DeepEqual(decimalShrinks, []interface{}{
+		float32(0), float32(12), float32(10), float32(12.3), float32(12.37), float32(6), float32(9), float32(11),
 	}) {
-		t.Errorf("Invalid tenShrinks: %#v", oneShrinks)
+		t.Errorf("Invalid decimalShrinks: %#v", decimalShrinks)
 	}
 
+	rangeShrinks := gen.Float32RangeShrinker(-10, -2)(float32(-2.5)).All()
+	if len(rangeShrinks) <= 3 || !reflect.DeepEqual(rangeShrinks[:3], []interface{}{float32(-2), float32(-2.25), float32(-2.375)}) {
+		t.Errorf("Invalid rangeShrinks: %#v", rangeShrinks)
+	}
 }
@@ -46,3 +46,53 @@ func TestFloat32Range(t *testing.T) {
 		return ok && v >= -1234.5 && v <= 56789.123
 	})
 }
+
+func TestAnyFloat64(t *testing.T) {
+	commonGeneratorTest(t, "any float 64", gen.AnyFloat64(), func(value interface{}) bool {
+		_, ok := value.(float64)
+		return ok
+	})
+
+	parameters := edgeCaseParameters()
+	anyFloat64s := gen.AnyFloat64()
+	nans := 0
+	for i := 0; i < 1000; i++ {
+		value, ok := anyFloat64s(parameters).Retrieve()
+		if !ok {
+			t.Errorf("Invalid edge case: %#v", value)
+		}
+		if math.IsNaN(value.(float64)) {
+			nans++
+		}
+	}
+	if nans == 0 {
+		t.Error("NaN never generated")
+	}
+}
+
+func TestAnyFloat32(t *testing.T) {
+	commonGeneratorTest(t, "any float 32", gen.AnyFloat32(), func(value interface{}) bool {
+		_, ok := value.(float32)
+		return ok
+	})
+}
+
+func TestFloatEdgeCases(t *testing.T) {
+	parameters := edgeCaseParameters()
+
+	float64s := gen.Float64Range(-1.5, 2.5)
+	for i := 0; i < 100; i++ {
+		value, ok := float64s(parameters).Retrieve()
+		if !ok || (value.(float64) != -1.5 && value.(float64) != 2.5 && value.(float64) != 0) {
+			t.Errorf("Invalid edge case: %#v", value)
+		}
+	}
+
+	anyFloat64s := gen.Float64()
+	for i := 0; i < 100; i++ {
+		value, ok := anyFloat64s(parameters).Retrieve()
+		if !ok || math.IsNaN(value.(float64)) || math.IsInf(value.(float64), 0) {
+			t.Errorf("Invalid edge case: %#v", value)
+		}
+	}
+}
@@ -9,6 +9,8 @@ import (
 // Frequency combines multiple weighted generators of the the same result type
 // The generators from weightedGens will be used accrding to the weight, i.e. generators
 // with a hight weight will be used more often than generators with a low weight.
+// Values are shrinked toward (simple) values of the generators with a higher weight,
+// then by the shrinker of the generator the value was taken from.
 func Frequency(weightedGens map[int]gopter.Gen) gopter.Gen {
 	if len(weightedGens) == 0 {
 		return Fail(nil)
@@ -22,12 +24,14 @@ func Frequency(weightedGens map[int]gopter.Gen) gopter.Gen {
 		weights = append(weights, weight)
 	}
 	weights.Sort()
+	// alternatives ordered by descending weight
+	gens := make([]gopter.Gen, len(weights))
+	for i, weight := range weights {
+		gens[len(weights)-1-i] = weightedGens[weight]
+	}
+	a := newAlternatives(gens)
 	return func(genParams *gopter.GenParameters) *gopter.GenResult {
 		idx := weights.Search(genParams.Rng.Intn(max + 1))
-		gen := weightedGens[weights[idx]]
-
-		result := gen(genParams)
-		result.Sieve = nil
-		return result
+		return a.result(len(weights)-1-idx, genParams)
 	}
 }
@@ -46,3 +46,24 @@ func TestFrequency(t *testing.T) {
 		}
 	}
 }
+
+func TestFrequencyShrink(t *testing.T) {
+	frequency := gen.Frequency(map[int]gopter.Gen{
+		1: gen.Int64Range(10, 100),
+		9: gen.Const(int64(-1)),
+	})
+	result := frequency(fixedParameters(10, 0))
+	value, ok := result.Retrieve()
+	if !ok || value.(int64) < 10 {
+		t.Fatalf("Invalid value: %#v", value)
+	}
+	shrinks := result.Shrinker(value).Filter(result.Sieve).All()
+	if len(shrinks) == 0 || shrinks[0] != int64(-1) {
+		t.Errorf("Not shrinked toward alternative with higher weight: %#v", shrinks)
+	}
+	for _, shrink := range shrinks[1:] {
+		if shrink.(int64) < 10 || shrink.(int64) > 100 {
+			t.Errorf("Sieve of alternative not retained: %#v", shrinks)
+		}
+	}
+}
@@ -0,0 +1,397 @@
+package gen
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/leanovate/gopter"
+)
+
+// Grammar is a context free grammar mapping the names of its non-terminals to
+// their productions.
+// A Grammar may be created by ParseGrammar from an EBNF description or directly
+// in Go by using GrammarLiteral, GrammarRange, GrammarRef, GrammarSeq, GrammarAlt,
+// GrammarOpt, GrammarRep and GrammarToken.
+type Grammar map[string]GrammarExpr
+
+// GrammarExpr is an expression on the right hand side of a grammar production
+type GrammarExpr interface {
+	// generate creates a derivation of the expression
+	generate(g *grammarGen, genParams *gopter.GenParameters) *grammarNode
+	// cost calculates the size of the smallest derivation
+	cost(costs map[string]int) int
+	// refs collects all non-terminals referenced by the expression
+	refs() []string
+}
+
+type grammarLiteral struct {
+	text string
+}
+
+// GrammarLiteral is a terminal that always produces the given text
+func GrammarLiteral(text string) GrammarExpr {
+	return &grammarLiteral{text: text}
+}
+
+func (l *grammarLiteral) generate(g *grammarGen, genParams *gopter.GenParameters) *grammarNode {
+	return &grammarNode{text: l.text}
+}
+
+func (l *grammarLiteral) cost(costs map[string]int) int {
+	return 1
+}
+
+func (l *grammarLiteral) refs() []string {
+	return nil
+}
+
+type grammarRange struct {
+	lo, hi rune
+}
+
+// GrammarRange is a terminal that produces a single character within [lo, hi]
+func GrammarRange(lo, hi rune) GrammarExpr {
+	return &grammarRange{lo: lo, hi: hi}
+}
+
+func (r *grammarRange) generate(g *grammarGen, genParams *gopter.GenParameters) *grammarNode {
+	if g.minimal() {
+		return &grammarNode{text: string(r.lo)}
+	}
+	return &grammarNode{text: string(r.lo + rune(genParams.Rng.Int63n(int64(r.hi-r.lo)+1)))}
+}
+
+func (r *grammarRange) cost(costs map[string]int) int {
+	return 1
+}
+
+func (r *grammarRange) refs() []string {
+	return nil
+}
+
+type grammarToken struct {
+	gen gopter.Gen
+}
+
+// GrammarToken is a terminal whose text is created by a string generator.
+// This is useful for token classes like identifiers or numbers, the generated
+// tokens will be shrinked by the shrinker of the generator.
+// If the generator does not produce a value (e.g. because of a sieve) it is retried
+// a limited number of times.
+func GrammarToken(gen gopter.Gen) GrammarExpr {
+	return &grammarToken{gen: gen}
+}
+
+// maxTokenRetries limits the attempts to generate a token, if the token generator does not
+// produce a value (e.g. because of a sieve)
+const maxTokenRetries = 100
+
+func (t *grammarToken) generate(g *grammarGen, genParams *gopter.GenParameters) *grammarNode {
+	for i := 0; i < maxTokenRetries; i++ {
+		result := t.gen(genParams)
+		if value, ok := result.Retrieve(); ok {
+			return &grammarNode{text: value.(string), token: result}
+		}
+	}
+	return nil
+}
+
+func (t *grammarToken) cost(costs map[string]int) int {
+	return 1
+}
+
+func (t *grammarToken) refs() []string {
+	return nil
+}
+
+type grammarRef struct {
+	name string
+}
+
+// GrammarRef refers to a non-terminal of the grammar
+func GrammarRef(name string) GrammarExpr {
+	return &grammarRef{name: name}
+}
+
+func (r *grammarRef) generate(g *grammarGen, genParams *gopter.GenParameters) *grammarNode {
+	g.budget--
+	child := g.grammar[r.name].generate(g, genParams)
+	if child == nil {
+		return nil
+	}
+	return &grammarNode{name: r.name, children: []*grammarNode{child}}
+}
+
+func (r *grammarRef) cost(costs map[string]int) int {
+	if c, ok := costs[r.name]; ok && c < math.MaxInt32 {
+		return 1 + c
+	}
+	return math.MaxInt32
+}
+
+func (r *grammarRef) refs() []string {
+	return []string{r.name}
+}
+
+type grammarSeq struct {
+	exprs []GrammarExpr
+}
+
+// GrammarSeq is a sequence of expressions
+func GrammarSeq(exprs ...GrammarExpr) GrammarExpr {
+	return &grammarSeq{exprs: exprs}
+}
+
+func (s *grammarSeq) generate(g *grammarGen, genParams *gopter.GenParameters) *grammarNode {
+	children := make([]*grammarNode, len(s.exprs))
+	for i, expr := range s.exprs {
+		if children[i] = expr.generate(g, genParams); children[i] == nil {
+			return nil
+		}
+	}
+	return &grammarNode{children: children}
+}
+
+func (s *grammarSeq) cost(costs map[string]int) int {
+	result := 1
+	for _, expr := range s.exprs {
+		c := expr.cost(costs)
+		if c >= math.MaxInt32 {
+			return math.MaxInt32
+		}
+		result += c
+	}
+	return result
+}
+
+func (s *grammarSeq) refs() []string {
+	result := []string{}
+	for _, expr := range s.exprs {
+		result = append(result, expr.refs()...)
+	}
+	return result
+}
+
+type grammarAlt struct {
+	exprs []GrammarExpr
+}
+
+// GrammarAlt is a choice between alternative expressions
+func GrammarAlt(exprs ...GrammarExpr) GrammarExpr {
+	return &grammarAlt{exprs: exprs}
+}
+
+func (a *grammarAlt) generate(g *grammarGen, genParams *gopter.GenParameters) *grammarNode {
+	if len(a.exprs) == 0 {
+		return nil
+	}
+	if g.minimal() {
+		return a.cheapest(g.costs).generate(g, genParams)
+	}
+	return a.exprs[genParams.Rng.Intn(len(a.exprs))].generate(g, genParams)
+}
+
+func (a *grammarAlt) cheapest(costs map[string]int) GrammarExpr {
+	cheapest := a.exprs[0]
+	for _, expr := range a.exprs[1:] {
+		if expr.cost(costs) < cheapest.cost(costs) {
+			cheapest = expr
+		}
+	}
+	return cheapest
+}
+
+func (a *grammarAlt) cost(costs map[string]int) int {
+	result := math.MaxInt32
+	for _, expr := range a.exprs {
+		if c := expr.cost(costs); c < result {
+			result = c
+		}
+	}
+	return result
+}
+
+func (a *grammarAlt) refs() []string {
+	result := []string{}
+	for _, expr := range a.exprs {
+		result = append(result, expr.refs()...)
+	}
+	return result
+}
+
+type grammarOpt struct {
+	expr GrammarExpr
+}
+
+// GrammarOpt is an optional expression
+func GrammarOpt(expr GrammarExpr) GrammarExpr {
+	return &grammarOpt{expr: expr}
+}
+
+func (o *grammarOpt) generate(g *grammarGen, genParams *gopter.GenParameters) *grammarNode {
+	if g.minimal() || genParams.NextBool() {
+		return &grammarNode{optional: true}
+	}
+	child := o.expr.generate(g, genParams)
+	if child == nil {
+		return nil
+	}
+	return &grammarNode{optional: true, children: []*grammarNode{child}}
+}
+
+func (o *grammarOpt) cost(costs map[string]int) int {
+	return 1
+}
+
+func (o *grammarOpt) refs() []string {
+	return o.expr.refs()
+}
+
+type grammarRep struct {
+	expr GrammarExpr
+}
+
+// GrammarRep is an expression that may be repeated zero or more times
+func GrammarRep(expr GrammarExpr) GrammarExpr {
+	return &grammarRep{expr: expr}
+}
+
+func (r *grammarRep) generate(g *grammarGen, genParams *gopter.GenParameters) *grammarNode {
+	children := []*grammarNode{}
+	for len(children) < genParams.MaxSize && !g.minimal() && genParams.Rng.Intn(4) > 0 {
+		child := r.expr.generate(g, genParams)
+		if child == nil {
+			return nil
+		}
+		children = append(children, child)
+	}
+	return &grammarNode{repetition: true, children: children}
+}
+
+func (r *grammarRep) cost(costs map[string]int) int {
+	return 1
+}
+
+func (r *grammarRep) refs() []string {
+	return r.expr.refs()
+}
+
+// Validate checks that all non-terminals reachable from start are defined and
+// have a finite derivation.
+func (g Grammar) Validate(start string) error {
+	_, err := g.costs(start)
+	return err
+}
+
+// costs calculates the size of the smallest derivation of each non-terminal
+func (g Grammar) costs(start string) (map[string]int, error) {
+	reachable := map[string]bool{}
+	pending := []string{start}
+	for len(pending) > 0 {
+		name := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if reachable[name] {
+			continue
+		}
+		expr, ok := g[name]
+		if !ok || expr == nil {
+			return nil, fmt.Errorf("undefined non-terminal: %s", name)
+		}
+		reachable[name] = true
+		pending = append(pending, expr.refs()...)
+	}
+
+	costs := make(map[string]int, len(reachable))
+	for name := range reachable {
+		costs[name] = math.MaxInt32
+	}
+	for changed := true; changed; {
+		changed = false
+		for name := range reachable {
+			if c := g[name].cost(costs); c < costs[name] {
+				costs[name] = c
+				changed = true
+			}
+		}
+	}
+	infinite := []string{}
+	for name, c := range costs {
+		if c >= math.MaxInt32 {
+			infinite = append(infinite, name)
+		}
+	}
+	if len(infinite) > 0 {
+		sort.Strings(infinite)
+		return nil, fmt.Errorf("non-terminals without finite derivation: %s", strings.Join(infinite, ", "))
+	}
+	return costs, nil
+}
+
+type grammarGen struct {
+	grammar Grammar
+	costs   map[string]int
+	// budget is the number of non-terminals that may still be expanded freely.
+	// Once the budget is exhausted only the smallest derivations are generated.
+	budget int
+}
+
+func (g *grammarGen) minimal() bool {
+	return g.budget <= 0
+}
+
+// minimalParams are used to create simple values (like the tokens of the smallest
+// derivations) while shrinking, i.e. without access to the original GenParameters
+func minimalParams() *gopter.GenParameters {
+	return &gopter.GenParameters{
+		Rng: rand.New(rand.NewSource(0)),
+	}
+}
+
+// GrammarGen generates strings derived from the start non-terminal of a grammar.
+// genParams.MaxSize limits the number of non-terminals that are expanded at random,
+// once this budget is exhausted the smallest possible derivations are used. Thereby
+// the size of the generated strings as well as the depth of recursion is bounded.
+// Generated values are shrinked by replacing subtrees of the derivation with smaller
+// derivations of the same non-terminal, by dropping elements of repetitions and options
+// and by dropping leading terms of lists (e.g. "a-b/c" is shrinked to "b/c" for
+// `Expr = Term { "-" Term } .`), i.e. shrinked values remain valid words of the grammar.
+func GrammarGen(grammar Grammar, start string) gopter.Gen {
+	costs, err := grammar.costs(start)
+	if err != nil {
+		return Fail(reflect.TypeOf(""))
+	}
+	root := GrammarRef(start)
+	shrinker := &grammarShrinker{
+		grammar: grammar,
+		costs:   costs,
+		trees:   newValueTable(),
+	}
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		g := &grammarGen{
+			grammar: grammar,
+			costs:   costs,
+			budget:  genParams.MaxSize + 1,
+		}
+		tree := root.generate(g, genParams)
+		if tree == nil {
+			return gopter.NewEmptyResult(reflect.TypeOf(""))
+		}
+		value := tree.String()
+		shrinker.trees.put(value, tree)
+		return gopter.NewGenResult(value, shrinker.shrink)
+	}
+}
+
+// GrammarMatch generates strings derived from the start non-terminal of a grammar
+// given in EBNF (see ParseGrammar).
+func GrammarMatch(ebnf string, start string) gopter.Gen {
+	grammar, err := ParseGrammar(ebnf)
+	if err != nil {
+		return Fail(reflect.TypeOf(""))
+	}
+	return GrammarGen(grammar, start)
+}
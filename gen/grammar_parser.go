@@ -0,0 +1,170 @@
+package gen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/scanner"
+	"unicode/utf8"
+)
+
+type grammarParser struct {
+	scanner scanner.Scanner
+	token   rune
+	errors  []string
+}
+
+func (p *grammarParser) next() {
+	p.token = p.scanner.Scan()
+}
+
+func (p *grammarParser) errorf(format string, args ...interface{}) {
+	p.errors = append(p.errors, fmt.Sprintf("%s: %s", p.scanner.Position, fmt.Sprintf(format, args...)))
+}
+
+func (p *grammarParser) expect(token rune) {
+	if p.token != token {
+		p.errorf("expected %s, found %s", scanner.TokenString(token), scanner.TokenString(p.token))
+	}
+	p.next()
+}
+
+func (p *grammarParser) parseString() string {
+	value, err := strconv.Unquote(p.scanner.TokenText())
+	if err != nil {
+		p.errorf("invalid string literal %s", p.scanner.TokenText())
+	}
+	p.next()
+	return value
+}
+
+// parseRangeOperator checks for a range operator, i.e. "…" or "..."
+func (p *grammarParser) parseRangeOperator() bool {
+	if p.token == '…' {
+		p.next()
+		return true
+	}
+	if p.token == '.' && p.scanner.Peek() == '.' {
+		p.next()
+		p.expect('.')
+		p.expect('.')
+		return true
+	}
+	return false
+}
+
+func (p *grammarParser) parseTerm() GrammarExpr {
+	switch p.token {
+	case scanner.Ident:
+		name := p.scanner.TokenText()
+		p.next()
+		return GrammarRef(name)
+	case scanner.String, scanner.RawString:
+		lo := p.parseString()
+		if !p.parseRangeOperator() {
+			return GrammarLiteral(lo)
+		}
+		if p.token != scanner.String && p.token != scanner.RawString {
+			p.errorf("expected string literal, found %s", scanner.TokenString(p.token))
+			return nil
+		}
+		hi := p.parseString()
+		if utf8.RuneCountInString(lo) != 1 || utf8.RuneCountInString(hi) != 1 {
+			p.errorf("range bounds have to be single characters: %q … %q", lo, hi)
+			return nil
+		}
+		loRune, _ := utf8.DecodeRuneInString(lo)
+		hiRune, _ := utf8.DecodeRuneInString(hi)
+		if hiRune < loRune {
+			p.errorf("invalid range: %q … %q", lo, hi)
+			return nil
+		}
+		return GrammarRange(loRune, hiRune)
+	case '(':
+		p.next()
+		expr := p.parseExpression()
+		p.expect(')')
+		return expr
+	case '[':
+		p.next()
+		expr := p.parseExpression()
+		p.expect(']')
+		return GrammarOpt(expr)
+	case '{':
+		p.next()
+		expr := p.parseExpression()
+		p.expect('}')
+		return GrammarRep(expr)
+	}
+	return nil
+}
+
+func (p *grammarParser) parseAlternative() GrammarExpr {
+	terms := []GrammarExpr{}
+	for term := p.parseTerm(); term != nil; term = p.parseTerm() {
+		terms = append(terms, term)
+	}
+	if len(terms) == 1 {
+		return terms[0]
+	}
+	return GrammarSeq(terms...)
+}
+
+func (p *grammarParser) parseExpression() GrammarExpr {
+	alternatives := []GrammarExpr{p.parseAlternative()}
+	for p.token == '|' {
+		p.next()
+		alternatives = append(alternatives, p.parseAlternative())
+	}
+	if len(alternatives) == 1 {
+		return alternatives[0]
+	}
+	return GrammarAlt(alternatives...)
+}
+
+/*
+ParseGrammar parses a grammar in EBNF as it is used in the Go language specification:
+
+	Production  = name "=" [ Expression ] "." .
+	Expression  = Alternative { "|" Alternative } .
+	Alternative = Term { Term } .
+	Term        = name | token [ "…" token ] | Group | Option | Repetition .
+	Group       = "(" Expression ")" .
+	Option      = "[" Expression "]" .
+	Repetition  = "{" Expression "}" .
+
+Tokens are Go string literals, ranges (e.g. "a" … "z") have to be single characters,
+"..." may be used instead of "…". Comments are written like Go comments.
+The productions of the resulting Grammar may be modified or extended afterwards,
+e.g. to replace a production by a GrammarToken.
+*/
+func ParseGrammar(ebnf string) (Grammar, error) {
+	p := &grammarParser{}
+	p.scanner.Init(strings.NewReader(ebnf))
+	p.scanner.Mode = scanner.ScanIdents | scanner.ScanStrings | scanner.ScanRawStrings | scanner.ScanComments | scanner.SkipComments
+	p.scanner.Error = func(s *scanner.Scanner, msg string) {
+		p.errorf("%s", msg)
+	}
+	p.next()
+
+	grammar := Grammar{}
+	for p.token != scanner.EOF && len(p.errors) == 0 {
+		if p.token != scanner.Ident {
+			p.errorf("expected production name, found %s", scanner.TokenString(p.token))
+			break
+		}
+		name := p.scanner.TokenText()
+		p.next()
+		p.expect('=')
+		expr := p.parseExpression()
+		p.expect('.')
+		if _, ok := grammar[name]; ok {
+			p.errorf("%s declared twice", name)
+		}
+		grammar[name] = expr
+	}
+	if len(p.errors) > 0 {
+		return nil, fmt.Errorf("invalid grammar: %s", strings.Join(p.errors, "; "))
+	}
+	return grammar, nil
+}
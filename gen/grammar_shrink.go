@@ -0,0 +1,215 @@
+package gen
+
+import (
+	"strings"
+
+	"github.com/leanovate/gopter"
+)
+
+// grammarNode is a node in the derivation tree of a grammar
+type grammarNode struct {
+	// name of the non-terminal (if the node is the derivation of a non-terminal)
+	name string
+	// text of a terminal
+	text string
+	// token is the generator result of a GrammarToken
+	token      *gopter.GenResult
+	optional   bool
+	repetition bool
+	children   []*grammarNode
+}
+
+func (n *grammarNode) String() string {
+	var builder strings.Builder
+	n.writeTo(&builder)
+	return builder.String()
+}
+
+func (n *grammarNode) writeTo(builder *strings.Builder) {
+	builder.WriteString(n.text)
+	for _, child := range n.children {
+		child.writeTo(builder)
+	}
+}
+
+func (n *grammarNode) size() int {
+	result := 1
+	for _, child := range n.children {
+		result += child.size()
+	}
+	return result
+}
+
+// withChild creates a copy of the node with the i-th child replaced
+func (n *grammarNode) withChild(i int, child *grammarNode) *grammarNode {
+	result := *n
+	result.children = make([]*grammarNode, len(n.children))
+	copy(result.children, n.children)
+	result.children[i] = child
+	return &result
+}
+
+// withoutChild creates a copy of the node with the i-th child removed
+func (n *grammarNode) withoutChild(i int) *grammarNode {
+	result := *n
+	result.children = make([]*grammarNode, 0, len(n.children)-1)
+	result.children = append(append(result.children, n.children[:i]...), n.children[i+1:]...)
+	return &result
+}
+
+// descendants collects all (proper) descendants that are derivations of a non-terminal
+func (n *grammarNode) descendants(name string, result []*grammarNode) []*grammarNode {
+	for _, child := range n.children {
+		if child.name == name {
+			result = append(result, child)
+		}
+		result = child.descendants(name, result)
+	}
+	return result
+}
+
+type grammarShrinker struct {
+	grammar Grammar
+	costs   map[string]int
+	// trees remembers the derivation trees of the values created by the generator,
+	// so that the shrinker can continue with any of them.
+	trees *valueTable
+}
+
+func (s *grammarShrinker) shrink(v interface{}) gopter.Shrink {
+	value := v.(string)
+	entry, ok := s.trees.get(value)
+	if !ok {
+		return gopter.NoShrink
+	}
+	candidates := s.candidates(entry.(*grammarNode))
+	seen := map[string]bool{value: true}
+	return func() (interface{}, bool) {
+		for next, ok := candidates(); ok; next, ok = candidates() {
+			candidate := next.(*grammarNode)
+			str := candidate.String()
+			if seen[str] {
+				continue
+			}
+			seen[str] = true
+			s.trees.put(str, candidate)
+			return str, true
+		}
+		return nil, false
+	}
+}
+
+// candidates creates all smaller variants of a (sub-)tree, the most
+// aggressive ones come first. The variants are created lazily.
+func (s *grammarShrinker) candidates(node *grammarNode) gopter.Shrink {
+	shrinks := []gopter.Shrink{}
+	if node.name != "" {
+		shrinks = append(shrinks, lazyShrink(func() gopter.Shrink {
+			result := []*grammarNode{}
+			if minimal := s.minimal(node.name); minimal != nil && minimal.size() < node.size() {
+				result = append(result, minimal)
+			}
+			return nodeShrink(node.descendants(node.name, result))
+		}))
+	}
+	if (node.repetition || node.optional) && len(node.children) > 0 {
+		shrinks = append(shrinks, lazyShrink(func() gopter.Shrink {
+			result := []*grammarNode{{repetition: node.repetition, optional: node.optional}}
+			if len(node.children) > 1 {
+				for i := range node.children {
+					result = append(result, node.withoutChild(i))
+				}
+			}
+			return nodeShrink(result)
+		}))
+	}
+	shrinks = append(shrinks, lazyShrink(func() gopter.Shrink {
+		return nodeShrink(node.withoutLeading())
+	}))
+	if node.token != nil {
+		shrinks = append(shrinks, lazyShrink(func() gopter.Shrink {
+			return node.token.Shrinker(node.text).Filter(node.token.Sieve).Map(func(value string) *grammarNode {
+				return &grammarNode{text: value, token: node.token}
+			})
+		}))
+	}
+	for i, child := range node.children {
+		i, child := i, child
+		shrinks = append(shrinks, lazyShrink(func() gopter.Shrink {
+			return s.candidates(child).Map(func(candidate *grammarNode) *grammarNode {
+				return node.withChild(i, candidate)
+			})
+		}))
+	}
+	return gopter.ConcatShrinks(shrinks...)
+}
+
+// withoutLeading creates the variants of a sequence like `X { "," X }` that
+// drop a leading X, i.e. the X is replaced by the X of an element of the
+// following repetition and that element is removed.
+func (n *grammarNode) withoutLeading() []*grammarNode {
+	result := []*grammarNode{}
+	for i, leading := range n.children {
+		if leading.name == "" {
+			continue
+		}
+		for k := i + 1; k < len(n.children); k++ {
+			rep := n.children[k]
+			if !rep.repetition {
+				continue
+			}
+			for j, element := range rep.children {
+				if replacement := element.child(leading.name); replacement != nil {
+					result = append(result, n.withChild(i, replacement).withChild(k, rep.withoutChild(j)))
+				}
+			}
+		}
+	}
+	return result
+}
+
+// child finds the node itself or its first direct child that is a derivation
+// of a non-terminal
+func (n *grammarNode) child(name string) *grammarNode {
+	if n.name == name {
+		return n
+	}
+	for _, child := range n.children {
+		if child.name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+// lazyShrink defers the creation of a shrink until its first value is requested
+func lazyShrink(create func() gopter.Shrink) gopter.Shrink {
+	var shrink gopter.Shrink
+	return func() (interface{}, bool) {
+		if shrink == nil {
+			shrink = create()
+		}
+		return shrink()
+	}
+}
+
+// nodeShrink creates a shrink of a fixed list of nodes
+func nodeShrink(nodes []*grammarNode) gopter.Shrink {
+	return func() (interface{}, bool) {
+		if len(nodes) == 0 {
+			return nil, false
+		}
+		next := nodes[0]
+		nodes = nodes[1:]
+		return next, true
+	}
+}
+
+// minimal creates the smallest derivation of a non-terminal
+func (s *grammarShrinker) minimal(name string) *grammarNode {
+	g := &grammarGen{
+		grammar: s.grammar,
+		costs:   s.costs,
+	}
+	return GrammarRef(name).generate(g, minimalParams())
+}
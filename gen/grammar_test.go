@@ -0,0 +1,135 @@
+package gen_test
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+const exprGrammar = `
+Expr    = Term { ( "+" | "-" ) Term } .
+Term    = Factor { ( "*" | "/" ) Factor } .
+Factor  = Number | Ident | "(" Expr ")" .
+Number  = "1" … "9" { "0" ... "9" } .
+Ident   = . // replaced by a token generator
+`
+
+func exprGrammarGen(t *testing.T) gopter.Gen {
+	grammar, err := gen.ParseGrammar(exprGrammar)
+	if err != nil {
+		t.Fatal(err)
+	}
+	grammar["Ident"] = gen.GrammarToken(gen.Identifier().SuchThat(func(ident string) bool {
+		return !token.IsKeyword(ident)
+	}))
+	return gen.GrammarGen(grammar, "Expr")
+}
+
+func TestGrammarGen(t *testing.T) {
+	commonGeneratorTest(t, "expressions", exprGrammarGen(t), func(value interface{}) bool {
+		str, ok := value.(string)
+		if !ok {
+			return false
+		}
+		_, err := parser.ParseExpr(str)
+		return err == nil
+	})
+
+	commonGeneratorTest(t, "grammar match", gen.GrammarMatch(`S = "a" S "b" | "ab" .`, "S"), func(value interface{}) bool {
+		str, ok := value.(string)
+		n := len(str) / 2
+		return ok && n > 0 && str == strings.Repeat("a", n)+strings.Repeat("b", n)
+	})
+
+	params := gopter.DefaultGenParameters()
+	for size := 0; size < 5; size++ {
+		value, ok := gen.GrammarMatch(`S = "(" S ")" | "x" .`, "S")(params.WithSize(size)).Retrieve()
+		if !ok || len(value.(string)) > 2*size+3 {
+			t.Errorf("Recursion not bound by size %d: %#v", size, value)
+		}
+	}
+}
+
+func TestGrammarInvalid(t *testing.T) {
+	for _, ebnf := range []string{
+		`S = "a" `,
+		`S = "ab" … "z" .`,
+		`S = ( "a" .`,
+		`S = "a" . S = "b" .`,
+		`= "a" .`,
+	} {
+		if _, err := gen.ParseGrammar(ebnf); err == nil {
+			t.Errorf("Expected error for %s", ebnf)
+		}
+		if value, ok := gen.GrammarMatch(ebnf, "S").Sample(); ok {
+			t.Errorf("Invalid value for %s: %#v", ebnf, value)
+		}
+	}
+
+	for _, ebnf := range []string{
+		`S = T .`,
+		`S = "a" S .`,
+	} {
+		grammar, err := gen.ParseGrammar(ebnf)
+		if err != nil {
+			t.Error(err)
+		}
+		if grammar.Validate("S") == nil {
+			t.Errorf("Expected validation error for %s", ebnf)
+		}
+		if value, ok := gen.GrammarGen(grammar, "S").Sample(); ok {
+			t.Errorf("Invalid value for %s: %#v", ebnf, value)
+		}
+	}
+}
+
+func TestGrammarShrink(t *testing.T) {
+	exprs := exprGrammarGen(t)
+	parameters := gopter.DefaultTestParametersWithSeed(1234)
+	result := prop.ForAll(func(expr string) bool {
+		return !strings.Contains(expr, "/")
+	}, exprs).Check(parameters)
+	if result.Passed() || len(result.Args) != 1 {
+		t.Fatalf("Invalid result: %#v", result)
+	}
+	shrunk := result.Args[0].Arg.(string)
+	if _, err := parser.ParseExpr(shrunk); err != nil {
+		t.Errorf("Shrunk value is not in the language: %#v", shrunk)
+	}
+	if shrunk != "g/r" {
+		t.Errorf("Expression not shrunk to minimum: %#v (original %#v)", shrunk, result.Args[0].OrigArg)
+	}
+}
+
+func TestGrammarShrinkSliceElements(t *testing.T) {
+	parameters := gopter.DefaultTestParametersWithSeed(1234)
+	result := prop.ForAll(func(words []string) bool {
+		for _, word := range words {
+			if len(word) > 2 {
+				return false
+			}
+		}
+		return true
+	}, gen.SliceOfN(5, gen.GrammarMatch(`S = "x" { "0" … "9" } .`, "S"))).Check(parameters)
+	if result.Passed() || len(result.Args) != 1 {
+		t.Fatalf("Invalid result: %#v", result)
+	}
+	long := 0
+	for _, word := range result.Args[0].Arg.([]string) {
+		switch len(word) {
+		case 1:
+		case 3:
+			long++
+		default:
+			t.Errorf("Element not shrunk to minimum: %#v", word)
+		}
+	}
+	if long != 1 {
+		t.Errorf("Invalid shrunk value: %#v", result.Args[0].Arg)
+	}
+}
@@ -31,3 +31,9 @@ func commonGeneratorTest(t *testing.T, name string, gen gopter.Gen, valueCheck f
 		}
 	}
 }
+
+func edgeCaseParameters() *gopter.GenParameters {
+	parameters := gopter.DefaultGenParameters()
+	parameters.EdgeCaseProbability = 1
+	return parameters
+}
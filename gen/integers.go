@@ -12,21 +12,22 @@ func Int64Range(min, max int64) gopter.Gen {
 	if max < min {
 		return Fail(reflect.TypeOf(int64(0)))
 	}
+	edgeCases := int64EdgeCases(min, max)
 	if max == math.MaxInt64 && min == math.MinInt64 { // Check for range overflow
-		return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		return gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
 			return gopter.NewGenResult(genParams.NextInt64(), Int64Shrinker)
-		}
+		}).WithEdgeCases(edgeCases...)
 	}
 
 	rangeSize := uint64(max - min + 1)
-	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+	return gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
 		var nextResult = uint64(min) + (genParams.NextUint64() % rangeSize)
 		genResult := gopter.NewGenResult(int64(nextResult), Int64Shrinker)
 		genResult.Sieve = func(v interface{}) bool {
 			return v.(int64) >= min && v.(int64) <= max
 		}
 		return genResult
-	}
+	}).WithEdgeCases(edgeCases...)
 }
 
 // UInt64Range generates uint64 numbers within a given range
@@ -34,19 +35,20 @@ func UInt64Range(min, max uint64) gopter.Gen {
 	if max < min {
 		return Fail(reflect.TypeOf(uint64(0)))
 	}
+	edgeCases := uint64EdgeCases(min, max)
 	d := max - min + 1
 	if d == 0 { // Check overflow (i.e. max = MaxInt64, min = MinInt64)
-		return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		return gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
 			return gopter.NewGenResult(genParams.NextUint64(), UInt64Shrinker)
-		}
+		}).WithEdgeCases(edgeCases...)
 	}
-	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+	return gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
 		genResult := gopter.NewGenResult(min+genParams.NextUint64()%d, UInt64Shrinker)
 		genResult.Sieve = func(v interface{}) bool {
 			return v.(uint64) >= min && v.(uint64) <= max
 		}
 		return genResult
-	}
+	}).WithEdgeCases(edgeCases...)
 }
 
 // Int64 generates an arbitrary int64 number
@@ -183,6 +185,36 @@ func UInt() gopter.Gen {
 		WithShrinker(UIntShrinker)
 }
 
+// SizedInt64 generates int64 numbers whose magnitude is bounded by the size parameter,
+// i.e. numbers within [-genParams.MaxSize, genParams.MaxSize]
+func SizedInt64() gopter.Gen {
+	return Sized(func(size int) gopter.Gen {
+		return Int64Range(-int64(size), int64(size))
+	})
+}
+
+// SizedUInt64 generates uint64 numbers within [0, genParams.MaxSize]
+func SizedUInt64() gopter.Gen {
+	return Sized(func(size int) gopter.Gen {
+		return UInt64Range(0, uint64(size))
+	})
+}
+
+// SizedInt generates int numbers whose magnitude is bounded by the size parameter,
+// i.e. numbers within [-genParams.MaxSize, genParams.MaxSize]
+func SizedInt() gopter.Gen {
+	return Sized(func(size int) gopter.Gen {
+		return IntRange(-size, size)
+	})
+}
+
+// SizedUInt generates uint numbers within [0, genParams.MaxSize]
+func SizedUInt() gopter.Gen {
+	return Sized(func(size int) gopter.Gen {
+		return UIntRange(0, uint(size))
+	})
+}
+
 // Size just extracts the MaxSize field of the GenParameters.
 // This can be helpful to generate limited integer value in a more structued
 // manner.
@@ -192,6 +224,37 @@ func Size() gopter.Gen {
 	}
 }
 
+// int64EdgeCases collects the boundary values (min, max, 0, 1, -1) that are within [min, max]
+func int64EdgeCases(min, max int64) []interface{} {
+	edgeCases := []interface{}{min}
+	for _, v := range []int64{max, 0, 1, -1} {
+		if v >= min && v <= max && !containsEdgeCase(edgeCases, v) {
+			edgeCases = append(edgeCases, v)
+		}
+	}
+	return edgeCases
+}
+
+// uint64EdgeCases collects the boundary values (min, max, 0, 1) that are within [min, max]
+func uint64EdgeCases(min, max uint64) []interface{} {
+	edgeCases := []interface{}{min}
+	for _, v := range []uint64{max, 0, 1} {
+		if v >= min && v <= max && !containsEdgeCase(edgeCases, v) {
+			edgeCases = append(edgeCases, v)
+		}
+	}
+	return edgeCases
+}
+
+func containsEdgeCase(edgeCases []interface{}, v interface{}) bool {
+	for _, edgeCase := range edgeCases {
+		if edgeCase == v {
+			return true
+		}
+	}
+	return false
+}
+
 func int64To32(value int64) int32 {
 	return int32(value)
 }
@@ -2,6 +2,7 @@ package gen_test
 
 import (
 	"math"
+	"reflect"
 	"testing"
 
 	"github.com/leanovate/gopter"
@@ -128,3 +129,41 @@ func TestGenSize(t *testing.T) {
 		}
 	}
 }
+
+func TestIntEdgeCases(t *testing.T) {
+	parameters := edgeCaseParameters()
+
+	seen := map[int64]bool{}
+	int64s := gen.Int64Range(-10, 10)
+	for i := 0; i < 100; i++ {
+		value, ok := int64s(parameters).Retrieve()
+		if !ok {
+			t.Errorf("Invalid edge case: %#v", value)
+		}
+		seen[value.(int64)] = true
+	}
+	if !reflect.DeepEqual(seen, map[int64]bool{-10: true, 10: true, 0: true, 1: true, -1: true}) {
+		t.Errorf("Invalid edge cases: %#v", seen)
+	}
+
+	seen = map[int64]bool{}
+	positive := gen.Int64Range(5, math.MaxInt64)
+	for i := 0; i < 100; i++ {
+		value, ok := positive(parameters).Retrieve()
+		if !ok {
+			t.Errorf("Invalid edge case: %#v", value)
+		}
+		seen[value.(int64)] = true
+	}
+	if !reflect.DeepEqual(seen, map[int64]bool{5: true, math.MaxInt64: true}) {
+		t.Errorf("Invalid edge cases: %#v", seen)
+	}
+
+	uint8s := gen.UInt8()
+	for i := 0; i < 100; i++ {
+		value, ok := uint8s(parameters).Retrieve()
+		if !ok || (value.(uint8) != 0 && value.(uint8) != 1 && value.(uint8) != math.MaxUint8) {
+			t.Errorf("Invalid edge case: %#v", value)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package gen
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/leanovate/gopter"
+)
+
+// DefaultLocationNames are the time zones used by Location. They cover DST on either
+// hemisphere, zones without DST, offsets that are not full hours and extreme offsets.
+var DefaultLocationNames = []string{
+	"UTC",
+	"America/New_York",
+	"America/Los_Angeles",
+	"America/Sao_Paulo",
+	"America/St_Johns",
+	"Europe/London",
+	"Europe/Berlin",
+	"Europe/Moscow",
+	"Africa/Casablanca",
+	"Asia/Kolkata",
+	"Asia/Kathmandu",
+	"Asia/Tokyo",
+	"Australia/Sydney",
+	"Australia/Lord_Howe",
+	"Pacific/Auckland",
+	"Pacific/Chatham",
+	"Pacific/Apia",
+	"Pacific/Kiritimati",
+	"Etc/GMT+12",
+}
+
+// Location generates an arbitrary *time.Location of DefaultLocationNames
+func Location() gopter.Gen {
+	return LocationOf(DefaultLocationNames...)
+}
+
+// LocationOf generates one of the *time.Location with the given names.
+// The locations are loaded from the time zone database of the system. The generator
+// fails if one of the names is unknown, i.e. also if the system does not provide a time
+// zone database. The embedded copy of the database is not linked by default (it adds
+// about 450KB to every binary), tests that need it regardless of the system should
+// import _ "time/tzdata" or be built with -tags timetzdata.
+func LocationOf(names ...string) gopter.Gen {
+	locations := make([]*time.Location, len(names))
+	for i, name := range names {
+		location, err := time.LoadLocation(name)
+		if err != nil {
+			return Fail(reflect.TypeOf(time.UTC))
+		}
+		locations[i] = location
+	}
+	if len(locations) == 0 {
+		return Fail(reflect.TypeOf(time.UTC))
+	}
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		return gopter.NewGenResult(locations[genParams.Rng.Intn(len(locations))], LocationShrinker)
+	}
+}
+
+// LocationShrinker is a shrinker for *time.Location shrinking toward UTC
+func LocationShrinker(v interface{}) gopter.Shrink {
+	if v.(*time.Location) == time.UTC {
+		return gopter.NoShrink
+	}
+	return shrinkValues([]interface{}{time.UTC})
+}
+
+// TimeIn generates times of timeGen converted to the locations of locationGen.
+// Values are shrinked by converting them to UTC first and then by the shrinker
+// of timeGen.
+func TimeIn(timeGen gopter.Gen, locationGen gopter.Gen) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		timeResult := timeGen(genParams)
+		value, ok := timeResult.Retrieve()
+		if !ok {
+			return gopter.NewEmptyResult(reflect.TypeOf(time.Time{}))
+		}
+		location, ok := locationGen(genParams).Retrieve()
+		if !ok {
+			return gopter.NewEmptyResult(reflect.TypeOf(time.Time{}))
+		}
+		result := gopter.NewGenResult(value.(time.Time).In(location.(*time.Location)), func(v interface{}) gopter.Shrink {
+			t := v.(time.Time)
+			return gopter.ConcatShrinks(
+				LocationShrinker(t.Location()).Map(func(location *time.Location) time.Time {
+					return t.In(location)
+				}),
+				timeResult.Shrinker(t).Filter(timeResult.Sieve).Map(func(shrunk time.Time) time.Time {
+					return shrunk.In(t.Location())
+				}),
+			)
+		})
+		result.Sieve = timeResult.Sieve
+		return result
+	}
+}
@@ -0,0 +1,45 @@
+package gen_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	// the tests must not depend on the time zone database of the system
+	_ "time/tzdata"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+)
+
+func TestLocation(t *testing.T) {
+	commonGeneratorTest(t, "location", gen.Location(), func(value interface{}) bool {
+		_, ok := value.(*time.Location)
+		return ok
+	})
+	if value, ok := gen.LocationOf("UTC", "Invalid/Zone").Sample(); ok {
+		t.Errorf("Invalid value: %#v", value)
+	}
+
+	shrinks := gen.LocationShrinker(time.FixedZone("X", 3600)).All()
+	if !reflect.DeepEqual(shrinks, []interface{}{time.UTC}) {
+		t.Errorf("Invalid shrinks: %#v", shrinks)
+	}
+	if shrinks := gen.LocationShrinker(time.UTC).All(); len(shrinks) != 0 {
+		t.Errorf("Invalid shrinks: %#v", shrinks)
+	}
+}
+
+func TestTimeIn(t *testing.T) {
+	from := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	timeGen := gen.TimeIn(gen.TimeRange(from, 24*time.Hour), gen.LocationOf("Asia/Kathmandu"))
+	result := timeGen(gopter.DefaultGenParameters())
+	value, ok := result.Retrieve()
+	if !ok || value.(time.Time).Location().String() != "Asia/Kathmandu" {
+		t.Fatalf("Invalid value: %#v", value)
+	}
+	shrunk, ok := result.Shrinker(value)()
+	if !ok || shrunk.(time.Time).Location() != time.UTC || !shrunk.(time.Time).Equal(value.(time.Time)) {
+		t.Errorf("Not shrunk to UTC first: %v", shrunk)
+	}
+}
@@ -11,18 +11,7 @@ import (
 // genParams.MinSize sets an (inclusive) lower limit on the size of the map
 func MapOf(keyGen, elementGen gopter.Gen) gopter.Gen {
 	return func(genParams *gopter.GenParameters) *gopter.GenResult {
-		len := 0
-		if genParams.MaxSize > 0 || genParams.MinSize > 0 {
-			if genParams.MinSize > genParams.MaxSize {
-				panic("GenParameters.MinSize must be <= GenParameters.MaxSize")
-			}
-
-			if genParams.MaxSize == genParams.MinSize {
-				len = genParams.MaxSize
-			} else {
-				len = genParams.Rng.Intn(genParams.MaxSize-genParams.MinSize) + genParams.MinSize
-			}
-		}
+		len := genLen(genParams)
 
 		result, keySieve, keyShrinker, elementSieve, elementShrinker := genMap(keyGen, elementGen, genParams, len)
 
@@ -34,6 +23,47 @@ func MapOf(keyGen, elementGen gopter.Gen) gopter.Gen {
 	}
 }
 
+// MapOfN generates a map of generated key values with exactly desiredlen entries.
+// Note: The generator fails if the key generator does not provide enough distinct keys.
+func MapOfN(desiredlen int, keyGen, elementGen gopter.Gen) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		keys, keySieve, keyShrinker := genUniqueSlice(keyGen, genParams, desiredlen, uniqueKey(nil), nil)
+		result, elementSieve, elementShrinker := genMapValues(keys, elementGen, genParams)
+		if keys.Len() < desiredlen {
+			return gopter.NewEmptyResult(result.Type())
+		}
+
+		genResult := gopter.NewGenResult(result.Interface(), MapShrinkerN(keyShrinker, elementShrinker))
+		genResult.Sieve = func(v interface{}) bool {
+			return reflect.ValueOf(v).Len() == desiredlen && forAllKeyValueSieve(keySieve, elementSieve)(v)
+		}
+		return genResult
+	}
+}
+
+func genMapValues(keys reflect.Value, elementGen gopter.Gen, genParams *gopter.GenParameters) (reflect.Value, func(interface{}) bool, gopter.Shrinker) {
+	element := elementGen(genParams)
+	elementSieve := element.Sieve
+	elementShrinker := element.Shrinker
+
+	result := reflect.MakeMapWithSize(reflect.MapOf(keys.Type().Elem(), element.ResultType), keys.Len())
+
+	for i := 0; i < keys.Len(); i++ {
+		elementValue, ok := element.Retrieve()
+
+		if ok {
+			if elementValue == nil {
+				result.SetMapIndex(keys.Index(i), reflect.Zero(element.ResultType))
+			} else {
+				result.SetMapIndex(keys.Index(i), reflect.ValueOf(elementValue))
+			}
+		}
+		element = elementGen(genParams)
+	}
+
+	return result, elementSieve, elementShrinker
+}
+
 func genMap(keyGen, elementGen gopter.Gen, genParams *gopter.GenParameters, len int) (reflect.Value, func(interface{}) bool, gopter.Shrinker, func(interface{}) bool, gopter.Shrinker) {
 	element := elementGen(genParams)
 	elementSieve := element.Sieve
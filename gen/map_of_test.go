@@ -94,3 +94,23 @@ func TestMapOfPanic(t *testing.T) {
 
 	mapGen(genParams).Retrieve()
 }
+
+func TestMapOfN(t *testing.T) {
+	mapGen := gen.MapOfN(5, gen.IntRange(0, 9), gen.AlphaString())
+	commonGeneratorTest(t, "map of n", mapGen, func(value interface{}) bool {
+		v, ok := value.(map[int]string)
+		return ok && len(v) == 5
+	})
+
+	genResult := mapGen(gopter.DefaultGenParameters())
+	value, _ := genResult.Retrieve()
+	for _, shrunk := range genResult.Shrinker(value).All() {
+		if len(shrunk.(map[int]string)) != 5 {
+			t.Errorf("Invalid shrink: %#v", shrunk)
+		}
+	}
+
+	if value, ok := gen.MapOfN(5, gen.IntRange(0, 3), gen.AlphaString()).Sample(); ok {
+		t.Errorf("Invalid value: %#v", value)
+	}
+}
@@ -3,6 +3,7 @@ package gen
 import (
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/leanovate/gopter"
 )
@@ -17,13 +18,18 @@ type mapShrinkOne struct {
 	lastKey          interface{}
 	elementExhausted bool
 	lastElement      interface{}
+	// unique prevents keys from being shrinked to other keys of the map (i.e. keeps the length)
+	unique bool
 }
 
 func (s *mapShrinkOne) nextKeyValue() (interface{}, interface{}, bool) {
-	for !s.keyExhausted && !s.elementExhausted {
+	for !s.keyExhausted || !s.elementExhausted {
 		s.state = !s.state
 		if s.state && !s.keyExhausted {
 			value, ok := s.keyShrink()
+			for ok && s.unique && s.collides(value) {
+				value, ok = s.keyShrink()
+			}
 			if ok {
 				s.lastKey = value
 				return s.lastKey, s.lastElement, true
@@ -41,6 +47,11 @@ func (s *mapShrinkOne) nextKeyValue() (interface{}, interface{}, bool) {
 	return nil, nil, false
 }
 
+// collides checks if a shrinked key is another key of the map
+func (s *mapShrinkOne) collides(key interface{}) bool {
+	return !reflect.DeepEqual(key, s.key.Interface()) && s.original.MapIndex(reflect.ValueOf(key)).IsValid()
+}
+
 func (s *mapShrinkOne) Next() (interface{}, bool) {
 	nextKey, nextValue, ok := s.nextKeyValue()
 	if !ok {
@@ -67,7 +78,7 @@ func MapShrinkerOne(keyShrinker, elementShrinker gopter.Shrinker) gopter.Shrinke
 			panic(fmt.Sprintf("%#v is not a map", v))
 		}
 
-		keys := rv.MapKeys()
+		keys := sortedMapKeys(rv)
 		shrinks := make([]gopter.Shrink, 0, len(keys))
 		for _, key := range keys {
 			mapShrinkOne := &mapShrinkOne{
@@ -84,6 +95,65 @@ func MapShrinkerOne(keyShrinker, elementShrinker gopter.Shrinker) gopter.Shrinke
 	}
 }
 
+// MapShrinkerN creates a map shrinker from a shrinker for the key values of a map.
+// The length of the map will remain unchanged, keys are only shrinked to values that
+// are not already contained in the map.
+func MapShrinkerN(keyShrinker, elementShrinker gopter.Shrinker) gopter.Shrinker {
+	return func(v interface{}) gopter.Shrink {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Map {
+			panic(fmt.Sprintf("%#v is not a map", v))
+		}
+
+		keys := sortedMapKeys(rv)
+		shrinks := make([]gopter.Shrink, 0, len(keys))
+		for _, key := range keys {
+			mapShrinkOne := &mapShrinkOne{
+				original:      rv,
+				key:           key,
+				keyShrink:     keyShrinker(key.Interface()),
+				lastKey:       key.Interface(),
+				elementShrink: elementShrinker(rv.MapIndex(key).Interface()),
+				lastElement:   rv.MapIndex(key).Interface(),
+				unique:        true,
+			}
+			shrinks = append(shrinks, mapShrinkOne.Next)
+		}
+		return gopter.ConcatShrinks(shrinks...)
+	}
+}
+
+// sortedMapKeys returns the keys of a map in a fixed order (unlike MapKeys), so that a map
+// is always shrinked the same way. Keys without a natural order are ordered by their
+// formatted value.
+func sortedMapKeys(rv reflect.Value) []reflect.Value {
+	keys := rv.MapKeys()
+	if hasNaturalOrder(rv.Type().Key()) {
+		sort.Slice(keys, func(i, j int) bool {
+			return naturalLess(keys[i].Interface(), keys[j].Interface())
+		})
+		return keys
+	}
+	formatted := make([]string, len(keys))
+	for i, key := range keys {
+		formatted[i] = fmt.Sprintf("%#v", key.Interface())
+	}
+	sort.Sort(formattedKeys{keys: keys, formatted: formatted})
+	return keys
+}
+
+type formattedKeys struct {
+	keys      []reflect.Value
+	formatted []string
+}
+
+func (f formattedKeys) Len() int           { return len(f.keys) }
+func (f formattedKeys) Less(i, j int) bool { return f.formatted[i] < f.formatted[j] }
+func (f formattedKeys) Swap(i, j int) {
+	f.keys[i], f.keys[j] = f.keys[j], f.keys[i]
+	f.formatted[i], f.formatted[j] = f.formatted[j], f.formatted[i]
+}
+
 type mapShrink struct {
 	original     reflect.Value
 	originalKeys []reflect.Value
@@ -122,7 +192,7 @@ func MapShrinker(keyShrinker, elementShrinker gopter.Shrinker) gopter.Shrinker {
 		if rv.Kind() != reflect.Map {
 			panic(fmt.Sprintf("%#v is not a Map", v))
 		}
-		keys := rv.MapKeys()
+		keys := sortedMapKeys(rv)
 		mapShrink := &mapShrink{
 			original:     rv,
 			originalKeys: keys,
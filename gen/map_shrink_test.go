@@ -1,9 +1,11 @@
 package gen_test
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
+	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
 )
 
@@ -55,3 +57,48 @@ func TestMapShrinker(t *testing.T) {
 		}
 	}
 }
+
+func TestMapShrinkerOneUnshrinkableKey(t *testing.T) {
+	// the key can not be shrinked, the element has to be shrinked nevertheless
+	mapShrink := gen.MapShrinkerOne(gen.StringShrinker, gen.Int64Shrinker)(map[string]int64{
+		"a": 100,
+	}).All()
+	if len(mapShrink) != 13 || !reflect.DeepEqual(mapShrink[0], map[string]int64{"a": 0}) {
+		t.Errorf("Invalid mapShrink: %#v", mapShrink)
+	}
+}
+
+func TestMapShrinkerN(t *testing.T) {
+	// the key 1 can only be shrinked to the other key 0, which is rejected
+	mapShrink := gen.MapShrinkerN(gen.Int64Shrinker, gen.Int64Shrinker)(map[int64]int64{
+		0: 0,
+		1: 8,
+	}).All()
+	if len(mapShrink) != 7 || !reflect.DeepEqual(mapShrink[0], map[int64]int64{0: 0, 1: 0}) {
+		t.Errorf("Invalid mapShrink: %#v", mapShrink)
+	}
+	for _, shrink := range mapShrink {
+		if len(shrink.(map[int64]int64)) != 2 {
+			t.Errorf("Invalid mapShrink: %#v", mapShrink)
+		}
+	}
+}
+
+func TestMapShrinkerReproducible(t *testing.T) {
+	original := map[int]string{}
+	for i := 0; i < 20; i++ {
+		original[i*7] = fmt.Sprintf("value %d", i)
+	}
+	for _, shrinker := range []gopter.Shrinker{
+		gen.MapShrinker(gen.IntShrinker, gen.StringShrinker),
+		gen.MapShrinkerOne(gen.IntShrinker, gen.StringShrinker),
+		gen.MapShrinkerN(gen.IntShrinker, gen.StringShrinker),
+	} {
+		expected := shrinker(original).All()
+		for i := 0; i < 10; i++ {
+			if shrinks := shrinker(original).All(); !reflect.DeepEqual(shrinks, expected) {
+				t.Fatalf("Shrinks of %#v differ between calls", original)
+			}
+		}
+	}
+}
@@ -7,23 +7,122 @@ import (
 )
 
 // OneConstOf generate one of a list of constant values
+// Values are shrinked toward the constants earlier in the list.
 func OneConstOf(consts ...interface{}) gopter.Gen {
 	if len(consts) == 0 {
 		return Fail(reflect.TypeOf(nil))
 	}
+	shrinker := func(v interface{}) gopter.Shrink {
+		for idx, value := range consts {
+			if reflect.DeepEqual(value, v) {
+				return shrinkValues(append([]interface{}{}, consts[:idx]...))
+			}
+		}
+		return gopter.NoShrink
+	}
 	return func(genParams *gopter.GenParameters) *gopter.GenResult {
 		idx := genParams.Rng.Intn(len(consts))
-		return gopter.NewGenResult(consts[idx], gopter.NoShrinker)
+		return gopter.NewGenResult(consts[idx], shrinker)
 	}
 }
 
 // OneGenOf generate one value from a a list of generators
+// Values are shrinked by trying (simple) values of the generators earlier in the list first,
+// then by the shrinker of the generator the value was taken from.
 func OneGenOf(gens ...gopter.Gen) gopter.Gen {
 	if len(gens) == 0 {
 		return Fail(reflect.TypeOf(nil))
 	}
+	a := newAlternatives(gens)
 	return func(genParams *gopter.GenParameters) *gopter.GenResult {
-		idx := genParams.Rng.Intn(len(gens))
-		return gens[idx](genParams)
+		return a.result(genParams.Rng.Intn(len(gens)), genParams)
+	}
+}
+
+// alternatives remembers which of the alternative generators each value was taken from
+// (including the shrinked ones), so that the values can be shrinked toward earlier
+// alternatives and are checked by the sieve of their own alternative.
+type alternatives struct {
+	gens   []gopter.Gen
+	values *valueTable
+}
+
+// alternative is the alternative generator a value was taken from
+type alternative struct {
+	idx    int
+	result *gopter.GenResult
+}
+
+func newAlternatives(gens []gopter.Gen) *alternatives {
+	return &alternatives{gens: gens, values: newValueTable()}
+}
+
+// result generates a value with the alternative generator idx
+func (a *alternatives) result(idx int, genParams *gopter.GenParameters) *gopter.GenResult {
+	result := a.gens[idx](genParams)
+	value, ok := result.Retrieve()
+	if !ok {
+		return result
+	}
+	a.values.put(value, &alternative{idx: idx, result: result})
+	return &gopter.GenResult{
+		Labels:     result.Labels,
+		Shrinker:   a.shrink,
+		ResultType: result.ResultType,
+		Result:     result.Result,
+		Sieve:      a.sieve,
+	}
+}
+
+func (a *alternatives) lookup(value interface{}) *alternative {
+	entry, ok := a.values.get(value)
+	if !ok {
+		return nil
+	}
+	return entry.(*alternative)
+}
+
+func (a *alternatives) sieve(v interface{}) bool {
+	alternative := a.lookup(v)
+	return alternative == nil || alternative.result.Sieve == nil || alternative.result.Sieve(v)
+}
+
+func (a *alternatives) shrink(v interface{}) gopter.Shrink {
+	alternative := a.lookup(v)
+	if alternative == nil {
+		return gopter.NoShrink
+	}
+	shrinks := make([]gopter.Shrink, 0, alternative.idx+1)
+	for idx := 0; idx < alternative.idx; idx++ {
+		shrinks = append(shrinks, a.simpleValue(idx))
+	}
+	shrinks = append(shrinks, a.recording(alternative.result.Shrinker(v).Filter(alternative.result.Sieve), alternative))
+	return gopter.ConcatShrinks(shrinks...)
+}
+
+// simpleValue creates a shrink with a single simple value of an alternative generator
+func (a *alternatives) simpleValue(idx int) gopter.Shrink {
+	done := false
+	return func() (interface{}, bool) {
+		if done {
+			return nil, false
+		}
+		done = true
+		result := a.gens[idx](minimalParams())
+		value, ok := result.Retrieve()
+		if ok {
+			a.values.put(value, &alternative{idx: idx, result: result})
+		}
+		return value, ok
+	}
+}
+
+func (a *alternatives) recording(shrink gopter.Shrink, source *alternative) gopter.Shrink {
+	return func() (interface{}, bool) {
+		value, ok := shrink()
+		if ok {
+			a.values.put(value, source)
+		}
+		return value, ok
 	}
 }
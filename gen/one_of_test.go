@@ -6,6 +6,7 @@ import (
 
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
 )
 
 func TestOneConstOf(t *testing.T) {
@@ -51,3 +52,79 @@ func commonOneOfTest(t *testing.T, gen gopter.Gen) {
 		t.Errorf("Not all consts where generated: %#v", generated)
 	}
 }
+
+func TestOneConstOfShrink(t *testing.T) {
+	consts := gen.OneConstOf("one", "two", "three")
+	result := consts(gopter.DefaultGenParameters())
+	if shrinks := result.Shrinker("three").All(); !reflect.DeepEqual(shrinks, []interface{}{"one", "two"}) {
+		t.Errorf("Invalid shrinks: %#v", shrinks)
+	}
+	if shrinks := result.Shrinker("one").All(); len(shrinks) != 0 {
+		t.Errorf("Invalid shrinks: %#v", shrinks)
+	}
+}
+
+func TestOneGenOfShrink(t *testing.T) {
+	oneOf := gen.OneGenOf(gen.Const(int64(-1)), gen.Int64Range(10, 100))
+	parameters := gopter.DefaultGenParameters()
+	for i := 0; i < 100; i++ {
+		result := oneOf(parameters)
+		value, ok := result.Retrieve()
+		if !ok {
+			t.Fatalf("Invalid value: %#v", value)
+		}
+		shrinks := result.Shrinker(value).Filter(result.Sieve).All()
+		if value.(int64) == -1 {
+			if len(shrinks) != 0 {
+				t.Errorf("Invalid shrinks of first alternative: %#v", shrinks)
+			}
+			continue
+		}
+		if len(shrinks) == 0 || shrinks[0] != int64(-1) {
+			t.Errorf("Not shrinked toward first alternative: %#v", shrinks)
+		}
+		for _, shrink := range shrinks[1:] {
+			if shrink.(int64) < 10 || shrink.(int64) > 100 {
+				t.Errorf("Sieve of alternative not retained: %#v", shrinks)
+			}
+		}
+	}
+}
+
+func TestOneGenOfShrinkSliceElements(t *testing.T) {
+	oneOfs := gen.SliceOfN(5, gen.OneGenOf(gen.IntRange(0, 1000), gen.IntRange(2000, 3000)))
+	result := prop.ForAll(func(vs []int) bool {
+		for _, v := range vs {
+			if v >= 500 {
+				return false
+			}
+		}
+		return true
+	}, oneOfs).Check(gopter.DefaultTestParameters())
+	if result.Passed() || len(result.Args) != 1 {
+		t.Fatalf("Invalid result: %#v", result)
+	}
+	// every element is shrinked to 0, except one that is shrinked to the minimum of its alternative
+	nonZero := []int{}
+	for _, v := range result.Args[0].Arg.([]int) {
+		if v != 0 {
+			nonZero = append(nonZero, v)
+		}
+	}
+	if len(nonZero) != 1 || (nonZero[0] != 500 && nonZero[0] != 2000) {
+		t.Errorf("Elements not shrinked: %#v", result.Args[0].Arg)
+	}
+}
+
+func TestOneGenOfShrinkBounded(t *testing.T) {
+	oneOf := gen.OneGenOf(gen.Const(0), gen.SliceOfN(50, gen.IntRange(0, 1000)))
+	result := prop.ForAll(func(v interface{}) bool {
+		return v == 0
+	}, oneOf).Check(gopter.DefaultTestParameters())
+	if result.Passed() || len(result.Args) != 1 {
+		t.Fatalf("Invalid result: %#v", result)
+	}
+	if !reflect.DeepEqual(result.Args[0].Arg, make([]int, 50)) {
+		t.Errorf("Not shrinked: %#v", result.Args[0].Arg)
+	}
+}
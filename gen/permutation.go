@@ -0,0 +1,233 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/leanovate/gopter"
+)
+
+// Permutation generates arbitrary permutations of a slice.
+// Values are shrinked toward the identity, i.e. the original order.
+func Permutation(slice interface{}) gopter.Gen {
+	original := sliceValue(slice)
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		indices := shuffledIndices(genParams, original.Len(), original.Len())
+		return gopter.NewGenResult(indices.apply(original), selectionShrinker(original, func(indices selection) []selection {
+			return append(indices.sorted(), indices.sortSteps()...)
+		}))
+	}
+}
+
+// SubsetOf generates arbitrary subsets of the elements of a slice in arbitrary order.
+// Values are shrinked toward smaller subsets and the original order.
+func SubsetOf(slice interface{}) gopter.Gen {
+	original := sliceValue(slice)
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		indices := chooseIndices(genParams, original.Len())
+		genParams.Rng.Shuffle(len(indices), func(i, j int) {
+			indices[i], indices[j] = indices[j], indices[i]
+		})
+		return gopter.NewGenResult(indices.apply(original), selectionShrinker(original, func(indices selection) []selection {
+			return append(append(indices.removals(), indices.sorted()...), indices.sortSteps()...)
+		}))
+	}
+}
+
+// SubsequenceOf generates arbitrary subsequences of a slice, i.e. some of its elements
+// in their original order.
+// Values are shrinked toward shorter subsequences.
+func SubsequenceOf(slice interface{}) gopter.Gen {
+	original := sliceValue(slice)
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		indices := chooseIndices(genParams, original.Len())
+		return gopter.NewGenResult(indices.apply(original), selectionShrinker(original, func(indices selection) []selection {
+			return indices.removals()
+		}))
+	}
+}
+
+// SampleN generates k distinct elements of a slice (i.e. drawn without replacement) in
+// arbitrary order. The generator fails if the slice has less than k elements.
+// Values are shrinked toward the first k elements of the slice in their original order.
+func SampleN(k int, slice interface{}) gopter.Gen {
+	original := sliceValue(slice)
+	if k < 0 || k > original.Len() {
+		return Fail(original.Type())
+	}
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		indices := shuffledIndices(genParams, original.Len(), k)
+		return gopter.NewGenResult(indices.apply(original), selectionShrinker(original, func(indices selection) []selection {
+			return append(append(indices.sorted(), indices.earlierSteps(original.Len())...), indices.sortSteps()...)
+		}))
+	}
+}
+
+func sliceValue(slice interface{}) reflect.Value {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		panic(fmt.Sprintf("%#v is not a slice", slice))
+	}
+	return rv
+}
+
+// shuffledIndices picks k of n indices in random order
+func shuffledIndices(genParams *gopter.GenParameters, n, k int) selection {
+	indices := genParams.Rng.Perm(n)
+	return selection(indices[:k])
+}
+
+// chooseIndices picks some of n indices in ascending order, with a bias toward none and all
+func chooseIndices(genParams *gopter.GenParameters, n int) selection {
+	indices := make(selection, 0, n)
+	if idx, ok := genParams.NextEdgeCase(2); ok {
+		for i := 0; idx == 1 && i < n; i++ {
+			indices = append(indices, i)
+		}
+		return indices
+	}
+	for i := 0; i < n; i++ {
+		if genParams.NextBool() {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// selection is a choice of elements of a slice given by their indices
+type selection []int
+
+func (s selection) apply(original reflect.Value) interface{} {
+	result := reflect.MakeSlice(original.Type(), len(s), len(s))
+	for i, idx := range s {
+		result.Index(i).Set(original.Index(idx))
+	}
+	return result.Interface()
+}
+
+func (s selection) without(from, to int) selection {
+	result := make(selection, 0, len(s)-(to-from))
+	return append(append(result, s[:from]...), s[to:]...)
+}
+
+// removals creates all selections with a chunk of elements removed, the largest
+// chunks come first
+func (s selection) removals() []selection {
+	result := []selection{}
+	if len(s) > 0 {
+		result = append(result, selection{})
+	}
+	for chunkLength := len(s) >> 1; chunkLength > 0; chunkLength >>= 1 {
+		for offset := 0; offset < len(s); offset += chunkLength {
+			end := offset + chunkLength
+			if end > len(s) {
+				end = len(s)
+			}
+			result = append(result, s.without(offset, end))
+		}
+	}
+	return result
+}
+
+// sorted creates the selection in original order (if it is not already sorted)
+func (s selection) sorted() []selection {
+	if sort.IntsAreSorted(s) {
+		return nil
+	}
+	result := append(selection{}, s...)
+	sort.Ints(result)
+	return []selection{result}
+}
+
+// sortSteps creates all selections where one element is swapped into its sorted position
+// or two adjacent elements in the wrong order are swapped
+func (s selection) sortSteps() []selection {
+	sorted := append(selection{}, s...)
+	sort.Ints(sorted)
+	result := []selection{}
+	for i := range s {
+		if s[i] == sorted[i] {
+			continue
+		}
+		for j := i + 1; j < len(s); j++ {
+			if s[j] == sorted[i] && s[j] < s[i] {
+				step := append(selection{}, s...)
+				step[i], step[j] = step[j], step[i]
+				result = append(result, step)
+				break
+			}
+		}
+	}
+	for i := 0; i+1 < len(s); i++ {
+		if s[i] > s[i+1] {
+			step := append(selection{}, s...)
+			step[i], step[i+1] = step[i+1], step[i]
+			result = append(result, step)
+		}
+	}
+	return result
+}
+
+// earlierSteps creates all selections where one element is replaced by the first element
+// of the slice (with n elements) that is not selected yet, if this is an earlier one
+func (s selection) earlierSteps(n int) []selection {
+	selected := make(map[int]bool, len(s))
+	for _, idx := range s {
+		selected[idx] = true
+	}
+	first := 0
+	for first < n && selected[first] {
+		first++
+	}
+	result := []selection{}
+	for i, idx := range s {
+		if first < idx {
+			step := append(selection{}, s...)
+			step[i] = first
+			result = append(result, step)
+		}
+	}
+	return result
+}
+
+// selectionShrinker creates a shrinker for selections of the elements of a slice.
+// The indices of a value are recovered by matching its elements with the original elements.
+func selectionShrinker(original reflect.Value, candidates func(selection) []selection) gopter.Shrinker {
+	return func(v interface{}) gopter.Shrink {
+		indices := matchIndices(original, reflect.ValueOf(v))
+		if indices == nil {
+			return gopter.NoShrink
+		}
+		values := []interface{}{}
+		seen := map[string]bool{}
+		for _, candidate := range candidates(indices) {
+			if key := fmt.Sprint(candidate); !seen[key] {
+				seen[key] = true
+				values = append(values, candidate.apply(original))
+			}
+		}
+		return shrinkValues(values)
+	}
+}
+
+// matchIndices finds the indices of the elements of a value in the original slice, nil if
+// the value is not a selection of the original
+func matchIndices(original, value reflect.Value) selection {
+	used := make([]bool, original.Len())
+	indices := make(selection, value.Len())
+	for i := range indices {
+		indices[i] = -1
+		for j := 0; j < original.Len(); j++ {
+			if !used[j] && reflect.DeepEqual(value.Index(i).Interface(), original.Index(j).Interface()) {
+				used[j] = true
+				indices[i] = j
+				break
+			}
+		}
+		if indices[i] < 0 {
+			return nil
+		}
+	}
+	return indices
+}
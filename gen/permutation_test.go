@@ -0,0 +1,100 @@
+package gen_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func sameElements(a, b []string) bool {
+	a, b = append([]string{}, a...), append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	return reflect.DeepEqual(a, b)
+}
+
+func isSubsequence(sub, of []string) bool {
+	for _, element := range of {
+		if len(sub) > 0 && sub[0] == element {
+			sub = sub[1:]
+		}
+	}
+	return len(sub) == 0
+}
+
+func TestPermutation(t *testing.T) {
+	original := []string{"a", "b", "c", "d", "e", "f", "a"}
+	commonGeneratorTest(t, "permutation", gen.Permutation(original), func(value interface{}) bool {
+		v, ok := value.([]string)
+		return ok && sameElements(v, original)
+	})
+
+	result := gen.Permutation([]int{1, 2, 3})(gopter.DefaultGenParameters())
+	if all := result.Shrinker([]int{3, 1, 2}).All(); !reflect.DeepEqual(all, []interface{}{
+		[]int{1, 2, 3},
+		[]int{1, 3, 2},
+	}) {
+		t.Errorf("Invalid shrinks: %#v", all)
+	}
+	if all := result.Shrinker([]int{1, 2, 3}).All(); len(all) != 0 {
+		t.Errorf("Invalid shrinks: %#v", all)
+	}
+}
+
+func TestSubsetOf(t *testing.T) {
+	original := []string{"a", "b", "c", "d", "e", "f"}
+	commonGeneratorTest(t, "subset", gen.SubsetOf(original), func(value interface{}) bool {
+		v, ok := value.([]string)
+		if !ok {
+			return false
+		}
+		sorted := append([]string{}, v...)
+		sort.Strings(sorted)
+		return isSubsequence(sorted, original)
+	})
+}
+
+func TestSubsequenceOf(t *testing.T) {
+	original := []string{"a", "b", "c", "d", "e", "f"}
+	commonGeneratorTest(t, "subsequence", gen.SubsequenceOf(original), func(value interface{}) bool {
+		v, ok := value.([]string)
+		return ok && isSubsequence(v, original)
+	})
+
+	result := prop.ForAll(func(v []string) bool {
+		return len(v) < 3
+	}, gen.SubsequenceOf(original)).Check(gopter.DefaultTestParameters())
+	if result.Passed() || len(result.Args) != 1 || len(result.Args[0].Arg.([]string)) != 3 {
+		t.Errorf("Invalid result: %#v", result)
+	}
+}
+
+func TestSampleN(t *testing.T) {
+	original := []string{"a", "b", "c", "d", "e", "f"}
+	commonGeneratorTest(t, "sample", gen.SampleN(3, original), func(value interface{}) bool {
+		v, ok := value.([]string)
+		if !ok || len(v) != 3 {
+			return false
+		}
+		sorted := append([]string{}, v...)
+		sort.Strings(sorted)
+		return isSubsequence(sorted, original)
+	})
+	if value, ok := gen.SampleN(7, original).Sample(); ok {
+		t.Errorf("Invalid value: %#v", value)
+	}
+
+	result := prop.ForAll(func(v []string) bool {
+		return v[0] != "c"
+	}, gen.SampleN(3, original)).Check(gopter.DefaultTestParameters())
+	if result.Passed() || len(result.Args) != 1 {
+		t.Fatalf("Invalid result: %#v", result)
+	}
+	if shrunk := result.Args[0].Arg.([]string); !reflect.DeepEqual(shrunk, []string{"c", "a", "b"}) {
+		t.Errorf("Invalid shrunk value: %#v", shrunk)
+	}
+}
@@ -2,77 +2,187 @@ package gen
 
 import (
 	"reflect"
-	"regexp"
 	"regexp/syntax"
-	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/leanovate/gopter"
 )
 
+// regexMaxAttempts is the number of derivations tried before giving up on a regular
+// expression whose zero-width assertions can not be satisfied (e.g. "a^b").
+// The assertions are checked after the derivation, so a regular expression whose
+// assertions hold for only a few of its derivations may give up as well.
+const regexMaxAttempts = 100
+
+var (
+	// anyCharRanges are all valid runes (i.e. without surrogates)
+	anyCharRanges = []rune{0, 0xD7FF, 0xE000, utf8.MaxRune}
+	// anyCharNotNLRanges are the runes generated for "." (like RuneNoControl)
+	anyCharNotNLRanges = []rune{32, 0xD7FF, 0xE000, utf8.MaxRune}
+)
+
 // RegexMatch generates matches for a given regular expression
-// regexStr is supposed to conform to the perl regular expression syntax
+// regexStr is supposed to conform to the perl regular expression syntax.
+// All operators are supported directly, i.e. bounded repetitions, anchors,
+// word boundaries, case folding and (negated) character classes.
+// Generated values are shrinked by shrinking the choices made while
+// deriving the match (i.e. fewer repetitions, earlier alternatives, simpler
+// characters), so that shrinked values always match the regular expression.
+// Zero-width assertions are checked once a match has been derived, a derivation
+// that violates them is replaced by a new one (up to 100 times before the generator
+// gives up). This is reliable for anchors at the start or end of the expression and
+// word boundaries at the edges of words, but assertions that only hold for rare
+// choices (e.g. ".*^x", where the repetition has to be empty) should be avoided.
 func RegexMatch(regexStr string) gopter.Gen {
-	regexSyntax, err1 := syntax.Parse(regexStr, syntax.Perl)
-	regex, err2 := regexp.Compile(regexStr)
-	if err1 != nil || err2 != nil {
+	regex, err := syntax.Parse(regexStr, syntax.Perl)
+	if err != nil {
 		return Fail(reflect.TypeOf(""))
 	}
-	return regexMatchGen(regexSyntax.Simplify()).SuchThat(func(v string) bool {
-		return regex.MatchString(v)
-	}).WithShrinker(StringShrinker)
+	shrinker := &regexShrinker{
+		trees: newValueTable(),
+	}
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		for i := 0; i < regexMaxAttempts; i++ {
+			tree := regexGenerate(regex, genParams)
+			if tree == nil || !tree.valid() {
+				continue
+			}
+			value := tree.String()
+			shrinker.trees.put(value, tree)
+			return gopter.NewGenResult(value, shrinker.shrink)
+		}
+		return gopter.NewEmptyResult(reflect.TypeOf(""))
+	}
 }
 
-func regexMatchGen(regex *syntax.Regexp) gopter.Gen {
+// regexGenerate creates a random derivation of a regular expression,
+// nil if the regular expression does not match anything
+func regexGenerate(regex *syntax.Regexp, genParams *gopter.GenParameters) *regexNode {
 	switch regex.Op {
+	case syntax.OpNoMatch:
+		return nil
 	case syntax.OpLiteral:
-		return Const(string(regex.Rune))
-	case syntax.OpCharClass:
-		gens := make([]gopter.Gen, 0, len(regex.Rune)/2)
-		for i := 0; i+1 < len(regex.Rune); i += 2 {
-			gens = append(gens, RuneRange(regex.Rune[i], regex.Rune[i+1]).Map(runeToString))
+		runes := make([]rune, len(regex.Rune))
+		for i, r := range regex.Rune {
+			if regex.Flags&syntax.FoldCase != 0 {
+				folds := regexFolds(r)
+				r = folds[genParams.Rng.Intn(len(folds))]
+			}
+			runes[i] = r
 		}
-		return OneGenOf(gens...)
-	case syntax.OpAnyChar:
-		return Rune().Map(runeToString)
-	case syntax.OpAnyCharNotNL:
-		return RuneNoControl().Map(runeToString)
-	case syntax.OpCapture:
-		return regexMatchGen(regex.Sub[0])
-	case syntax.OpStar:
-		elementGen := regexMatchGen(regex.Sub[0])
-		return SliceOf(elementGen).Map(func(v []string) string {
-			return strings.Join(v, "")
-		})
-	case syntax.OpPlus:
-		elementGen := regexMatchGen(regex.Sub[0])
-		return gopter.CombineGens(elementGen, SliceOf(elementGen)).Map(func(vs []interface{}) string {
-			return vs[0].(string) + strings.Join(vs[1].([]string), "")
-		})
-	case syntax.OpQuest:
-		elementGen := regexMatchGen(regex.Sub[0])
-		return OneGenOf(Const(""), elementGen)
-	case syntax.OpConcat:
-		gens := make([]gopter.Gen, len(regex.Sub))
-		for i, sub := range regex.Sub {
-			gens[i] = regexMatchGen(sub)
+		return &regexNode{regex: regex, runes: runes}
+	case syntax.OpCharClass, syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		ranges := regexRanges(regex)
+		if len(ranges) == 0 {
+			return nil
+		}
+		i := 2 * genParams.Rng.Intn(len(ranges)/2)
+		r := ranges[i] + rune(genParams.Rng.Int63n(int64(ranges[i+1]-ranges[i])+1))
+		return &regexNode{regex: regex, runes: []rune{r}}
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, max := regexRepeatBounds(regex)
+		count := min
+		if max < 0 {
+			count += genLen(genParams)
+		} else if idx, ok := genParams.NextEdgeCase(2); ok {
+			count = []int{min, max}[idx]
+		} else {
+			count += genParams.Rng.Intn(max - min + 1)
 		}
-		return gopter.CombineGens(gens...).Map(func(v []interface{}) string {
-			result := ""
-			for _, str := range v {
-				result += str.(string)
+		children := make([]*regexNode, count)
+		for i := range children {
+			if children[i] = regexGenerate(regex.Sub[0], genParams); children[i] == nil {
+				return nil
 			}
-			return result
-		})
+		}
+		return &regexNode{regex: regex, children: children}
 	case syntax.OpAlternate:
-		gens := make([]gopter.Gen, len(regex.Sub))
+		choice := genParams.Rng.Intn(len(regex.Sub))
+		child := regexGenerate(regex.Sub[choice], genParams)
+		if child == nil {
+			return nil
+		}
+		return &regexNode{regex: regex, choice: choice, children: []*regexNode{child}}
+	case syntax.OpCapture, syntax.OpConcat:
+		children := make([]*regexNode, len(regex.Sub))
 		for i, sub := range regex.Sub {
-			gens[i] = regexMatchGen(sub)
+			if children[i] = regexGenerate(sub, genParams); children[i] == nil {
+				return nil
+			}
+		}
+		return &regexNode{regex: regex, children: children}
+	}
+	// OpEmptyMatch and the zero-width assertions, the latter are checked once
+	// the derivation is complete
+	return &regexNode{regex: regex}
+}
+
+// regexRanges returns the ranges of runes matched by a character class (without surrogates)
+func regexRanges(regex *syntax.Regexp) []rune {
+	switch regex.Op {
+	case syntax.OpAnyChar:
+		return anyCharRanges
+	case syntax.OpAnyCharNotNL:
+		return anyCharNotNLRanges
+	}
+	ranges := make([]rune, 0, len(regex.Rune))
+	for i := 0; i+1 < len(regex.Rune); i += 2 {
+		lo, hi := regex.Rune[i], regex.Rune[i+1]
+		if lo < 0xD800 && hi > 0xDFFF {
+			ranges = append(ranges, lo, 0xD7FF, 0xE000, hi)
+			continue
+		}
+		if lo >= 0xD800 && lo <= 0xDFFF {
+			lo = 0xE000
+		}
+		if hi >= 0xD800 && hi <= 0xDFFF {
+			hi = 0xD7FF
 		}
-		return OneGenOf(gens...)
+		if lo <= hi {
+			ranges = append(ranges, lo, hi)
+		}
+	}
+	return ranges
+}
+
+// regexFolds returns all runes that are equivalent to r under simple case folding
+func regexFolds(r rune) []rune {
+	folds := []rune{r}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		folds = append(folds, f)
+	}
+	return folds
+}
+
+// regexRepeatBounds returns the minimum and maximum (-1 if unbounded) number of repetitions
+func regexRepeatBounds(regex *syntax.Regexp) (int, int) {
+	switch regex.Op {
+	case syntax.OpStar:
+		return 0, -1
+	case syntax.OpPlus:
+		return 1, -1
+	case syntax.OpQuest:
+		return 0, 1
 	}
-	return Const("")
+	return regex.Min, regex.Max
 }
 
-func runeToString(v rune) string {
-	return string(v)
+// regexAssertion returns the zero-width assertion of an operator (0 if there is none)
+func regexAssertion(op syntax.Op) syntax.EmptyOp {
+	switch op {
+	case syntax.OpBeginLine:
+		return syntax.EmptyBeginLine
+	case syntax.OpEndLine:
+		return syntax.EmptyEndLine
+	case syntax.OpBeginText:
+		return syntax.EmptyBeginText
+	case syntax.OpEndText:
+		return syntax.EmptyEndText
+	case syntax.OpWordBoundary:
+		return syntax.EmptyWordBoundary
+	case syntax.OpNoWordBoundary:
+		return syntax.EmptyNoWordBoundary
+	}
+	return 0
 }
@@ -0,0 +1,270 @@
+package gen
+
+import (
+	"regexp/syntax"
+
+	"github.com/leanovate/gopter"
+)
+
+// regexSimpleRunes are the preferred characters of shrinked matches, in order of simplicity
+const regexSimpleRunes = "abcdefghijklmnopqrstuvwxyz0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ _-.,"
+
+// regexMaxRuneCandidates limits the number of simpler characters tried for a single character
+const regexMaxRuneCandidates = 4
+
+// regexNode is a node in the derivation tree of a regular expression
+type regexNode struct {
+	regex *syntax.Regexp
+	// runes matched by a literal or character class
+	runes []rune
+	// choice is the index of the alternative taken by an alternation
+	choice   int
+	children []*regexNode
+}
+
+type regexPosition struct {
+	pos       int
+	assertion syntax.EmptyOp
+}
+
+func (n *regexNode) String() string {
+	runes, _ := n.collect(nil, nil)
+	return string(runes)
+}
+
+// collect gathers the matched runes and the positions of all zero-width assertions
+func (n *regexNode) collect(runes []rune, assertions []regexPosition) ([]rune, []regexPosition) {
+	if assertion := regexAssertion(n.regex.Op); assertion != 0 {
+		assertions = append(assertions, regexPosition{pos: len(runes), assertion: assertion})
+	}
+	runes = append(runes, n.runes...)
+	for _, child := range n.children {
+		runes, assertions = child.collect(runes, assertions)
+	}
+	return runes, assertions
+}
+
+// valid checks that all zero-width assertions of the derivation are satisfied
+func (n *regexNode) valid() bool {
+	runes, assertions := n.collect(nil, nil)
+	for _, a := range assertions {
+		before, after := rune(-1), rune(-1)
+		if a.pos > 0 {
+			before = runes[a.pos-1]
+		}
+		if a.pos < len(runes) {
+			after = runes[a.pos]
+		}
+		if syntax.EmptyOpContext(before, after)&a.assertion == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// withChild creates a copy of the node with the i-th child replaced
+func (n *regexNode) withChild(i int, child *regexNode) *regexNode {
+	result := *n
+	result.children = make([]*regexNode, len(n.children))
+	copy(result.children, n.children)
+	result.children[i] = child
+	return &result
+}
+
+// withoutChild creates a copy of the node with the i-th child removed
+func (n *regexNode) withoutChild(i int) *regexNode {
+	result := *n
+	result.children = make([]*regexNode, 0, len(n.children)-1)
+	result.children = append(append(result.children, n.children[:i]...), n.children[i+1:]...)
+	return &result
+}
+
+// regexRuneRank orders runes by simplicity
+func regexRuneRank(r rune) int {
+	for i, simple := range regexSimpleRunes {
+		if r == simple {
+			return i
+		}
+	}
+	return len(regexSimpleRunes) + int(r)
+}
+
+// regexLess checks if a match is simpler than another, i.e. shorter or of the same
+// length and consisting of simpler characters
+func regexLess(a, b []rune) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	for i := range a {
+		if ra, rb := regexRuneRank(a[i]), regexRuneRank(b[i]); ra != rb {
+			return ra < rb
+		}
+	}
+	return false
+}
+
+// regexSimplerRunes collects runes of a character class that are simpler than r,
+// the simplest ones come first
+func regexSimplerRunes(regex *syntax.Regexp, r rune) []rune {
+	ranges := regexRanges(regex)
+	contains := func(c rune) bool {
+		for i := 0; i+1 < len(ranges); i += 2 {
+			if c >= ranges[i] && c <= ranges[i+1] {
+				return true
+			}
+		}
+		return false
+	}
+	rank := regexRuneRank(r)
+	result := []rune{}
+	for _, simple := range regexSimpleRunes {
+		if len(result) >= regexMaxRuneCandidates || regexRuneRank(simple) >= rank {
+			break
+		}
+		if contains(simple) {
+			result = append(result, simple)
+		}
+	}
+	if len(ranges) > 0 && regexRuneRank(ranges[0]) < rank && len(result) < regexMaxRuneCandidates {
+		result = append(result, ranges[0])
+	}
+	return result
+}
+
+// regexMinimal creates the simplest derivation of a regular expression
+// (ignoring zero-width assertions), nil if it does not match anything
+func regexMinimal(regex *syntax.Regexp) *regexNode {
+	switch regex.Op {
+	case syntax.OpNoMatch:
+		return nil
+	case syntax.OpLiteral:
+		runes := make([]rune, len(regex.Rune))
+		for i, r := range regex.Rune {
+			if regex.Flags&syntax.FoldCase != 0 {
+				for _, f := range regexFolds(r) {
+					if regexRuneRank(f) < regexRuneRank(r) {
+						r = f
+					}
+				}
+			}
+			runes[i] = r
+		}
+		return &regexNode{regex: regex, runes: runes}
+	case syntax.OpCharClass, syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		ranges := regexRanges(regex)
+		if len(ranges) == 0 {
+			return nil
+		}
+		r := ranges[0]
+		if simpler := regexSimplerRunes(regex, r); len(simpler) > 0 {
+			r = simpler[0]
+		}
+		return &regexNode{regex: regex, runes: []rune{r}}
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, _ := regexRepeatBounds(regex)
+		children := make([]*regexNode, min)
+		for i := range children {
+			if children[i] = regexMinimal(regex.Sub[0]); children[i] == nil {
+				return nil
+			}
+		}
+		return &regexNode{regex: regex, children: children}
+	case syntax.OpAlternate:
+		var result *regexNode
+		for choice, sub := range regex.Sub {
+			child := regexMinimal(sub)
+			if child == nil {
+				continue
+			}
+			candidate := &regexNode{regex: regex, choice: choice, children: []*regexNode{child}}
+			if result == nil || regexLess([]rune(candidate.String()), []rune(result.String())) {
+				result = candidate
+			}
+		}
+		return result
+	case syntax.OpCapture, syntax.OpConcat:
+		children := make([]*regexNode, len(regex.Sub))
+		for i, sub := range regex.Sub {
+			if children[i] = regexMinimal(sub); children[i] == nil {
+				return nil
+			}
+		}
+		return &regexNode{regex: regex, children: children}
+	}
+	return &regexNode{regex: regex}
+}
+
+type regexShrinker struct {
+	// trees remembers the derivation trees of the values created by the generator,
+	// so that the shrinker can continue with any of them.
+	trees *valueTable
+}
+
+func (s *regexShrinker) shrink(v interface{}) gopter.Shrink {
+	value := v.(string)
+	entry, ok := s.trees.get(value)
+	if !ok {
+		return gopter.NoShrink
+	}
+	candidates := s.candidates(entry.(*regexNode))
+	current := []rune(value)
+	seen := map[string]bool{value: true}
+	return func() (interface{}, bool) {
+		for len(candidates) > 0 {
+			candidate := candidates[0]
+			candidates = candidates[1:]
+			str := candidate.String()
+			if seen[str] {
+				continue
+			}
+			seen[str] = true
+			if !regexLess([]rune(str), current) || !candidate.valid() {
+				continue
+			}
+			s.trees.put(str, candidate)
+			return str, true
+		}
+		return nil, false
+	}
+}
+
+// candidates collects all variants of a (sub-)tree with simpler choices, the most
+// aggressive ones come first
+func (s *regexShrinker) candidates(node *regexNode) []*regexNode {
+	result := []*regexNode{}
+	if minimal := regexMinimal(node.regex); minimal != nil {
+		result = append(result, minimal)
+	}
+	switch node.regex.Op {
+	case syntax.OpLiteral, syntax.OpCharClass, syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		if len(node.runes) == 1 && node.regex.Op != syntax.OpLiteral {
+			for _, r := range regexSimplerRunes(node.regex, node.runes[0]) {
+				result = append(result, &regexNode{regex: node.regex, runes: []rune{r}})
+			}
+		}
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, _ := regexRepeatBounds(node.regex)
+		if len(node.children) > min {
+			shortest := *node
+			shortest.children = node.children[:min]
+			result = append(result, &shortest)
+			if len(node.children) > min+1 {
+				for i := range node.children {
+					result = append(result, node.withoutChild(i))
+				}
+			}
+		}
+	case syntax.OpAlternate:
+		for choice := 0; choice < node.choice; choice++ {
+			if child := regexMinimal(node.regex.Sub[choice]); child != nil {
+				result = append(result, &regexNode{regex: node.regex, choice: choice, children: []*regexNode{child}})
+			}
+		}
+	}
+	for i, child := range node.children {
+		for _, candidate := range s.candidates(child) {
+			result = append(result, node.withChild(i, candidate))
+		}
+	}
+	return result
+}
@@ -5,7 +5,9 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
 )
 
 func TestRegexMatch(t *testing.T) {
@@ -16,6 +18,12 @@ func TestRegexMatch(t *testing.T) {
 		"ABCD.+1234",
 		"^[0-9]{3}[A-Z]{5,}[a-z]{10,20}$",
 		"(?s)[^0-9]*ABCD.*1234",
+		"(?i)hello[^a-z]world",
+		"\\bfoo\\b[ .]\\Bx?",
+		"(?m)^a$\\n^b$",
+		"x{0}y{2}z{1,3}",
+		"\\A(ab|cd)*\\z",
+		"[^\\x00-\\x{D7FF}]",
 	}
 	for _, regex := range regexs {
 		pattern, err := regexp.Compile(regex)
@@ -34,3 +42,110 @@ func TestRegexMatch(t *testing.T) {
 		t.Errorf("Invalid value: %#v", value)
 	}
 }
+
+func TestRegexMatchBounds(t *testing.T) {
+	pattern := regexp.MustCompile("^(ab){2,4}$")
+	parameters := gopter.DefaultGenParameters()
+	for i := 0; i < 100; i++ {
+		result := gen.RegexMatch("(ab){2,4}")(parameters)
+		if result.Sieve != nil {
+			t.Fatal("Regex generator should not need a sieve")
+		}
+		value, ok := result.Retrieve()
+		if !ok || !pattern.MatchString(value.(string)) {
+			t.Errorf("Invalid value: %#v", value)
+		}
+	}
+}
+
+func TestRegexMatchUnsatisfiable(t *testing.T) {
+	for _, regex := range []string{"a^b", "[^\\x00-\\x{10FFFF}]", "\\bx\\B"} {
+		if value, ok := gen.RegexMatch(regex).Sample(); ok {
+			t.Errorf("Invalid value for %s: %#v", regex, value)
+		}
+	}
+}
+
+func TestRegexMatchShrink(t *testing.T) {
+	regexs := []string{
+		"[a-z][0-9a-zA-Z]*",
+		"(?i)hello[^a-z]world",
+		"\\bfoo\\b[ .]\\Bx?",
+		"^[0-9]{3}[A-Z]{5,}[a-z]{10,20}$",
+	}
+	for _, regex := range regexs {
+		pattern := regexp.MustCompile(regex)
+		genResult := gen.RegexMatch(regex)(gopter.DefaultGenParameters())
+		value, _ := genResult.Retrieve()
+		shrink := genResult.Shrinker(value)
+		for count := 0; count < 1000; count++ {
+			shrunk, ok := shrink()
+			if !ok {
+				break
+			}
+			if !pattern.MatchString(shrunk.(string)) {
+				t.Errorf("Shrunk value of %#v does not match %s: %#v", value, regex, shrunk)
+			}
+		}
+	}
+
+	parameters := gopter.DefaultTestParameters()
+	result := prop.ForAll(func(str string) bool {
+		return len(str) < 10
+	}, gen.RegexMatch("1?(zero|one)[a-z]*0")).Check(parameters)
+	if result.Passed() || len(result.Args) != 1 {
+		t.Fatalf("Invalid result: %#v", result)
+	}
+	if shrunk := result.Args[0].Arg.(string); len(shrunk) != 10 || !regexp.MustCompile("^1?(zero|one)a*0$").MatchString(shrunk) {
+		t.Errorf("Match not shrunk to minimum: %#v (original %#v)", shrunk, result.Args[0].OrigArg)
+	}
+}
+
+func TestRegexMatchAssertions(t *testing.T) {
+	regexs := []string{
+		"^[0-9]{3}[A-Z]{5,}$",
+		"\\bfoo\\b[ .]\\Bx?",
+		"(?m)^a$\\n^b$",
+		"\\A(ab|cd)*\\z",
+		"(foo|bar)\\b[ ,]+\\bbaz\\w*\\b",
+	}
+	for _, regex := range regexs {
+		pattern := regexp.MustCompile(regex)
+		regexGen := gen.RegexMatch(regex)
+		parameters := gopter.DefaultGenParameters()
+		for seed := int64(0); seed < 1000; seed++ {
+			value, ok := regexGen(parameters.CloneWithSeed(seed)).Retrieve()
+			if !ok || !pattern.MatchString(value.(string)) {
+				t.Errorf("Invalid value for %s with seed %d: %#v", regex, seed, value)
+			}
+		}
+	}
+}
+
+func TestRegexMatchShrinkSliceElements(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	result := prop.ForAll(func(strs []string) bool {
+		for _, str := range strs {
+			if len(str) > 4 {
+				return false
+			}
+		}
+		return true
+	}, gen.SliceOfN(5, gen.RegexMatch("[a-z]{3,10}"))).Check(parameters)
+	if result.Passed() || len(result.Args) != 1 {
+		t.Fatalf("Invalid result: %#v", result)
+	}
+	long := 0
+	for _, str := range result.Args[0].Arg.([]string) {
+		switch str {
+		case "aaa":
+		case "aaaaa":
+			long++
+		default:
+			t.Errorf("Element not shrunk to minimum: %#v", str)
+		}
+	}
+	if long != 1 {
+		t.Errorf("Invalid shrunk value: %#v", result.Args[0].Arg)
+	}
+}
@@ -0,0 +1,102 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/leanovate/gopter"
+)
+
+// SetOf generates an arbitrary set of generated elements, i.e. a map[T]struct{}
+// genParams.MaxSize sets an (exclusive) upper limit on the size of the set
+// genParams.MinSize sets an (inclusive) lower limit on the size of the set
+// Note: If the element generator does not provide enough distinct elements the set might be
+// smaller than desired, the generator fails if it can not satisfy genParams.MinSize.
+func SetOf(elementGen gopter.Gen, typeOverrides ...reflect.Type) gopter.Gen {
+	var typeOverride reflect.Type
+	if len(typeOverrides) > 1 {
+		panic("too many type overrides specified, at most 1 may be provided.")
+	} else if len(typeOverrides) == 1 {
+		typeOverride = typeOverrides[0]
+	}
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		len := genLen(genParams)
+		elements, elementSieve, elementShrinker := genUniqueSlice(elementGen, genParams, len, uniqueKey(nil), typeOverride)
+		setType := reflect.MapOf(elements.Type().Elem(), reflect.TypeOf(struct{}{}))
+		if elements.Len() < genParams.MinSize {
+			return gopter.NewEmptyResult(setType)
+		}
+		result := reflect.MakeMapWithSize(setType, elements.Len())
+		for i := 0; i < elements.Len(); i++ {
+			result.SetMapIndex(elements.Index(i), reflect.ValueOf(struct{}{}))
+		}
+
+		genResult := gopter.NewGenResult(result.Interface(), SetShrinker(elementShrinker))
+		if elementSieve != nil {
+			genResult.Sieve = forAllKeyValueSieve(elementSieve, nil)
+		}
+		return genResult
+	}
+}
+
+type setShrinkOne struct {
+	original      reflect.Value
+	element       reflect.Value
+	elementShrink gopter.Shrink
+}
+
+func (s *setShrinkOne) Next() (interface{}, bool) {
+	for {
+		value, ok := s.elementShrink()
+		if !ok {
+			return nil, false
+		}
+		next := reflect.Zero(s.original.Type().Key())
+		if value != nil {
+			next = reflect.ValueOf(value)
+		}
+		if s.original.MapIndex(next).IsValid() {
+			continue
+		}
+		result := reflect.MakeMapWithSize(s.original.Type(), s.original.Len())
+		for _, element := range s.original.MapKeys() {
+			if element.Interface() != s.element.Interface() {
+				result.SetMapIndex(element, s.original.MapIndex(element))
+			}
+		}
+		result.SetMapIndex(next, reflect.ValueOf(struct{}{}))
+		return result.Interface(), true
+	}
+}
+
+// SetShrinker creates a shrinker for sets (see SetOf) from a shrinker for the elements.
+// The size of the set will be shrinked as well, elements are only shrinked to values that
+// are not already contained in the set.
+func SetShrinker(elementShrinker gopter.Shrinker) gopter.Shrinker {
+	return func(v interface{}) gopter.Shrink {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Map {
+			panic(fmt.Sprintf("%#v is not a set", v))
+		}
+		elements := sortedMapKeys(rv)
+		mapShrink := &mapShrink{
+			original:     rv,
+			originalKeys: elements,
+			offset:       0,
+			length:       rv.Len(),
+			chunkLength:  rv.Len() >> 1,
+		}
+
+		shrinks := make([]gopter.Shrink, 0, rv.Len()+1)
+		shrinks = append(shrinks, mapShrink.Next)
+		for _, element := range elements {
+			setShrinkOne := &setShrinkOne{
+				original:      rv,
+				element:       element,
+				elementShrink: elementShrinker(element.Interface()),
+			}
+			shrinks = append(shrinks, setShrinkOne.Next)
+		}
+		return gopter.ConcatShrinks(shrinks...)
+	}
+}
@@ -0,0 +1,62 @@
+package gen_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+)
+
+func TestSetOf(t *testing.T) {
+	commonGeneratorTest(t, "set", gen.SetOf(gen.AlphaString()), func(value interface{}) bool {
+		_, ok := value.(map[string]struct{})
+		return ok
+	})
+
+	genParams := gopter.DefaultGenParameters()
+	genParams.MinSize = 3
+	genParams.MaxSize = 4
+	for i := 0; i < 100; i++ {
+		value, ok := gen.SetOf(gen.IntRange(0, 3))(genParams).Retrieve()
+		if !ok || len(value.(map[int]struct{})) != 3 {
+			t.Errorf("Invalid value: %#v", value)
+		}
+	}
+}
+
+func TestSetShrinker(t *testing.T) {
+	shrinks := gen.SetShrinker(gen.IntShrinker)(map[int]struct{}{2: {}}).All()
+	if !reflect.DeepEqual(shrinks, []interface{}{
+		map[int]struct{}{0: {}},
+		map[int]struct{}{1: {}},
+		map[int]struct{}{-1: {}},
+	}) {
+		t.Errorf("Invalid shrinks: %#v", shrinks)
+	}
+
+	smaller := 0
+	for _, shrunk := range gen.SetShrinker(gen.IntShrinker)(map[int]struct{}{0: {}, 2: {}}).All() {
+		if len(shrunk.(map[int]struct{})) < 2 {
+			smaller++
+		}
+	}
+	if smaller != 2 {
+		t.Errorf("Elements shrinked to duplicates: %d", smaller-2)
+	}
+}
+
+func TestSetShrinkerReproducible(t *testing.T) {
+	original := map[string]struct{}{}
+	for i := 0; i < 20; i++ {
+		original[fmt.Sprintf("element %d", i)] = struct{}{}
+	}
+	shrinker := gen.SetShrinker(gen.StringShrinker)
+	expected := shrinker(original).All()
+	for i := 0; i < 10; i++ {
+		if shrinks := shrinker(original).All(); !reflect.DeepEqual(shrinks, expected) {
+			t.Fatalf("Shrinks of %#v differ between calls", original)
+		}
+	}
+}
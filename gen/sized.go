@@ -0,0 +1,12 @@
+package gen
+
+import "github.com/leanovate/gopter"
+
+// Sized creates a generator that depends on the size parameter.
+// f is invoked with genParams.MaxSize every time a value is generated, i.e. the
+// generator created by f may grow with the size ramp of a property check.
+func Sized(f func(size int) gopter.Gen) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		return f(genParams.MaxSize)(genParams)
+	}
+}
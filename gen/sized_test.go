@@ -0,0 +1,49 @@
+package gen_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+)
+
+func TestSized(t *testing.T) {
+	sizes := gen.Sized(func(size int) gopter.Gen {
+		return gen.Const(size)
+	})
+	params := gopter.DefaultGenParameters()
+	for i := 0; i < 100; i++ {
+		value, ok := sizes(params.WithSize(i)).Retrieve()
+		if !ok || value.(int) != i {
+			t.Errorf("Invalid size: %v", value)
+		}
+	}
+}
+
+func TestSizedNumbers(t *testing.T) {
+	params := gopter.DefaultGenParameters()
+	for size := 0; size < 100; size++ {
+		sizedParams := params.WithSize(size)
+		for i := 0; i < 10; i++ {
+			if value, ok := gen.SizedInt64()(sizedParams).Retrieve(); !ok || value.(int64) < -int64(size) || value.(int64) > int64(size) {
+				t.Errorf("Invalid sized int64 for %d: %#v", size, value)
+			}
+			if value, ok := gen.SizedUInt64()(sizedParams).Retrieve(); !ok || value.(uint64) > uint64(size) {
+				t.Errorf("Invalid sized uint64 for %d: %#v", size, value)
+			}
+			if value, ok := gen.SizedInt()(sizedParams).Retrieve(); !ok || value.(int) < -size || value.(int) > size {
+				t.Errorf("Invalid sized int for %d: %#v", size, value)
+			}
+			if value, ok := gen.SizedUInt()(sizedParams).Retrieve(); !ok || value.(uint) > uint(size) {
+				t.Errorf("Invalid sized uint for %d: %#v", size, value)
+			}
+			if value, ok := gen.SizedFloat64()(sizedParams).Retrieve(); !ok || math.Abs(value.(float64)) > float64(size) {
+				t.Errorf("Invalid sized float64 for %d: %#v", size, value)
+			}
+			if value, ok := gen.SizedFloat32()(sizedParams).Retrieve(); !ok || math.Abs(float64(value.(float32))) > float64(size) {
+				t.Errorf("Invalid sized float32 for %d: %#v", size, value)
+			}
+		}
+	}
+}
@@ -17,18 +17,7 @@ func SliceOf(elementGen gopter.Gen, typeOverrides ...reflect.Type) gopter.Gen {
 		typeOverride = typeOverrides[0]
 	}
 	return func(genParams *gopter.GenParameters) *gopter.GenResult {
-		len := 0
-		if genParams.MaxSize > 0 || genParams.MinSize > 0 {
-			if genParams.MinSize > genParams.MaxSize {
-				panic("GenParameters.MinSize must be <= GenParameters.MaxSize")
-			}
-
-			if genParams.MaxSize == genParams.MinSize {
-				len = genParams.MaxSize
-			} else {
-				len = genParams.Rng.Intn(genParams.MaxSize-genParams.MinSize) + genParams.MinSize
-			}
-		}
+		len := genLen(genParams)
 		result, elementSieve, elementShrinker := genSlice(elementGen, genParams, len, typeOverride)
 
 		genResult := gopter.NewGenResult(result.Interface(), SliceShrinker(elementShrinker))
@@ -65,6 +54,25 @@ func SliceOfN(desiredlen int, elementGen gopter.Gen, typeOverrides ...reflect.Ty
 	}
 }
 
+// genLen determines the length of a generated collection.
+// The length is within [MinSize, MaxSize), unless MinSize == MaxSize. Edge cases are
+// the smallest possible length and the one above (i.e. empty and one-element collections).
+func genLen(genParams *gopter.GenParameters) int {
+	if genParams.MaxSize <= 0 && genParams.MinSize <= 0 {
+		return 0
+	}
+	if genParams.MinSize > genParams.MaxSize {
+		panic("GenParameters.MinSize must be <= GenParameters.MaxSize")
+	}
+	if genParams.MaxSize == genParams.MinSize {
+		return genParams.MaxSize
+	}
+	if idx, ok := genParams.NextEdgeCase(2); ok && genParams.MinSize+idx < genParams.MaxSize {
+		return genParams.MinSize + idx
+	}
+	return genParams.Rng.Intn(genParams.MaxSize-genParams.MinSize) + genParams.MinSize
+}
+
 func genSlice(elementGen gopter.Gen, genParams *gopter.GenParameters, desiredlen int, typeOverride reflect.Type) (reflect.Value, func(interface{}) bool, gopter.Shrinker) {
 	element := elementGen(genParams)
 	elementSieve := element.Sieve
@@ -199,3 +199,22 @@ func (a specA) String() string { return "specA" }
 func (b specB) String() string { return "specB" }
 func genA() gopter.Gen         { return gen.Const(specA{}) }
 func genB() gopter.Gen         { return gen.Const(specB{}) }
+
+func TestSliceOfEdgeCases(t *testing.T) {
+	genParams := gopter.DefaultGenParameters()
+	genParams.EdgeCaseProbability = 1
+	genParams.MinSize = 3
+	genParams.MaxSize = 50
+	sliceGen := gen.SliceOf(gen.Const("element"))
+
+	for i := 0; i < 100; i++ {
+		sample, ok := sliceGen(genParams).Retrieve()
+
+		if !ok {
+			t.Error("Sample was not ok")
+		}
+		if l := len(sample.([]string)); l != 3 && l != 4 {
+			t.Errorf("Sample has invalid length: %#v", l)
+		}
+	}
+}
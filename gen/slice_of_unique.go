@@ -0,0 +1,165 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/leanovate/gopter"
+)
+
+// maxUniqueMisses is the number of consecutive duplicates that may be generated before
+// a collection of unique elements is considered to be complete
+const maxUniqueMisses = 100
+
+// SliceOfUnique generates an arbitrary slice of generated elements without duplicates.
+// keyFn: has to be a function with one parameter (matching the generated elements) returning
+// a comparable key that is unique for each element of the slice. If keyFn is nil the elements
+// themselves (which have to be comparable) are used as keys.
+// genParams.MaxSize sets an (exclusive) upper limit on the size of the slice
+// genParams.MinSize sets an (inclusive) lower limit on the size of the slice
+// Note: If the element generator does not provide enough distinct elements the slice might be
+// shorter than desired, the generator fails if it can not satisfy genParams.MinSize.
+func SliceOfUnique(elementGen gopter.Gen, keyFn interface{}, typeOverrides ...reflect.Type) gopter.Gen {
+	var typeOverride reflect.Type
+	if len(typeOverrides) > 1 {
+		panic("too many type overrides specified, at most 1 may be provided.")
+	} else if len(typeOverrides) == 1 {
+		typeOverride = typeOverrides[0]
+	}
+	key := uniqueKey(keyFn)
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		len := genLen(genParams)
+		result, elementSieve, elementShrinker := genUniqueSlice(elementGen, genParams, len, key, typeOverride)
+		if result.Len() < genParams.MinSize {
+			return gopter.NewEmptyResult(result.Type())
+		}
+
+		genResult := gopter.NewGenResult(result.Interface(), UniqueSliceShrinker(elementShrinker, keyFn))
+		genResult.Sieve = func(v interface{}) bool {
+			return uniqueSieve(key)(v) && (elementSieve == nil || forAllSieve(elementSieve)(v))
+		}
+		return genResult
+	}
+}
+
+// UniqueSliceShrinker creates a shrinker for slices without duplicates (see SliceOfUnique)
+// from a shrinker for the elements of the slice.
+// The length of the slice will be shrinked as well, elements are only shrinked to values whose
+// key does not collide with any other element.
+func UniqueSliceShrinker(elementShrinker gopter.Shrinker, keyFn interface{}) gopter.Shrinker {
+	key := uniqueKey(keyFn)
+	return func(v interface{}) gopter.Shrink {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice {
+			panic(fmt.Sprintf("%#v is not a slice", v))
+		}
+		sliceShrink := &sliceShrink{
+			original:    rv,
+			offset:      0,
+			length:      rv.Len(),
+			chunkLength: rv.Len() >> 1,
+		}
+		keys := make(map[interface{}]bool, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			keys[key(rv.Index(i).Interface())] = true
+		}
+
+		shrinks := make([]gopter.Shrink, 0, rv.Len()+1)
+		shrinks = append(shrinks, sliceShrink.Next)
+		for i := 0; i < rv.Len(); i++ {
+			elementKey := key(rv.Index(i).Interface())
+			sliceShrinkOne := &sliceShrinkOne{
+				original: rv,
+				index:    i,
+				elementShrink: elementShrinker(rv.Index(i).Interface()).Filter(func(element interface{}) bool {
+					k := key(element)
+					return k == elementKey || !keys[k]
+				}),
+			}
+			shrinks = append(shrinks, sliceShrinkOne.Next)
+		}
+		return gopter.ConcatShrinks(shrinks...)
+	}
+}
+
+func genUniqueSlice(elementGen gopter.Gen, genParams *gopter.GenParameters, desiredlen int, key func(interface{}) interface{}, typeOverride reflect.Type) (reflect.Value, func(interface{}) bool, gopter.Shrinker) {
+	element := elementGen(genParams)
+	elementSieve := element.Sieve
+	elementShrinker := element.Shrinker
+
+	sliceType := typeOverride
+	if sliceType == nil {
+		sliceType = element.ResultType
+	}
+
+	result := reflect.MakeSlice(reflect.SliceOf(sliceType), 0, desiredlen)
+	keys := make(map[interface{}]bool, desiredlen)
+
+	for misses := 0; result.Len() < desiredlen && misses < maxUniqueMisses; element = elementGen(genParams) {
+		value, ok := element.Retrieve()
+		if !ok {
+			misses++
+			continue
+		}
+		elementValue := reflect.Zero(sliceType)
+		if value != nil {
+			elementValue = reflect.ValueOf(value)
+		}
+		k := key(elementValue.Interface())
+		if keys[k] {
+			misses++
+			continue
+		}
+		keys[k] = true
+		misses = 0
+		result = reflect.Append(result, elementValue)
+	}
+
+	return result, elementSieve, elementShrinker
+}
+
+// uniqueKey converts a key function (see SliceOfUnique) to a func(interface{}) interface{},
+// nil is converted to the identity
+func uniqueKey(keyFn interface{}) func(interface{}) interface{} {
+	if keyFn == nil {
+		return func(v interface{}) interface{} {
+			return v
+		}
+	}
+	keyVal := reflect.ValueOf(keyFn)
+	keyType := keyVal.Type()
+
+	if keyVal.Kind() != reflect.Func {
+		panic(fmt.Sprintf("Key function has to be a func, but is %v", keyType.Kind()))
+	}
+	if keyType.NumIn() != 1 {
+		panic(fmt.Sprintf("Key function has to be a func with one param, but is %v", keyType.NumIn()))
+	}
+	if keyType.NumOut() != 1 {
+		panic(fmt.Sprintf("Key function has to be a func with one return value, but is %v", keyType.NumOut()))
+	} else if !keyType.Out(0).Comparable() {
+		panic(fmt.Sprintf("Key function has to return a comparable value, but returns %v", keyType.Out(0)))
+	}
+	return func(v interface{}) interface{} {
+		value := reflect.ValueOf(v)
+		if !value.IsValid() {
+			value = reflect.Zero(keyType.In(0))
+		}
+		return keyVal.Call([]reflect.Value{value})[0].Interface()
+	}
+}
+
+func uniqueSieve(key func(interface{}) interface{}) func(interface{}) bool {
+	return func(v interface{}) bool {
+		rv := reflect.ValueOf(v)
+		keys := make(map[interface{}]bool, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			k := key(rv.Index(i).Interface())
+			if keys[k] {
+				return false
+			}
+			keys[k] = true
+		}
+		return true
+	}
+}
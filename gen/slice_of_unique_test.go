@@ -0,0 +1,57 @@
+package gen_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+)
+
+func TestSliceOfUnique(t *testing.T) {
+	isUnique := func(value interface{}) bool {
+		v, ok := value.([]int)
+		if !ok {
+			return false
+		}
+		seen := map[int]bool{}
+		for _, element := range v {
+			if seen[element%10] || element < 0 || element >= 100 {
+				return false
+			}
+			seen[element%10] = true
+		}
+		return true
+	}
+	commonGeneratorTest(t, "unique slice", gen.SliceOfUnique(gen.IntRange(0, 99), func(v int) int {
+		return v % 10
+	}), isUnique)
+
+	genParams := gopter.DefaultGenParameters()
+	genParams.MaxSize = 50
+	for i := 0; i < 100; i++ {
+		value, ok := gen.SliceOfUnique(gen.IntRange(0, 9), nil)(genParams).Retrieve()
+		if !ok || reflect.ValueOf(value).Len() > 10 {
+			t.Errorf("Invalid value: %#v", value)
+		}
+	}
+
+	genParams.MinSize = 20
+	if value, ok := gen.SliceOfUnique(gen.IntRange(0, 9), nil)(genParams).Retrieve(); ok {
+		t.Errorf("Invalid value: %#v", value)
+	}
+}
+
+func TestUniqueSliceShrinker(t *testing.T) {
+	shrinks := gen.UniqueSliceShrinker(gen.IntShrinker, nil)([]int{0, 4}).All()
+	if !reflect.DeepEqual(shrinks, []interface{}{
+		[]int{4},
+		[]int{0},
+		[]int{0, 2},
+		[]int{0, -2},
+		[]int{0, 3},
+		[]int{0, -3},
+	}) {
+		t.Errorf("Invalid shrinks: %#v", shrinks)
+	}
+}
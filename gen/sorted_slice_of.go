@@ -0,0 +1,133 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/leanovate/gopter"
+)
+
+// SortedSliceOf generates an arbitrary slice of generated elements in ascending order.
+// lessFn: has to be a function with two parameters (matching the generated elements) returning
+// a bool. If lessFn is nil the natural order of the elements is used, which is only available for
+// numbers and strings.
+// genParams.MaxSize sets an (exclusive) upper limit on the size of the slice
+// genParams.MinSize sets an (inclusive) lower limit on the size of the slice
+func SortedSliceOf(elementGen gopter.Gen, lessFn interface{}, typeOverrides ...reflect.Type) gopter.Gen {
+	var typeOverride reflect.Type
+	if len(typeOverrides) > 1 {
+		panic("too many type overrides specified, at most 1 may be provided.")
+	} else if len(typeOverrides) == 1 {
+		typeOverride = typeOverrides[0]
+	}
+	if lessFn == nil {
+		elementType := typeOverride
+		if elementType == nil {
+			elementType = elementGen(gopter.DefaultGenParams).ResultType
+		}
+		if !hasNaturalOrder(elementType) {
+			panic(fmt.Sprintf("%v has no natural order, a less function has to be provided", elementType))
+		}
+	}
+	less := lessFunc(lessFn)
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		len := genLen(genParams)
+		result, elementSieve, elementShrinker := genSlice(elementGen, genParams, len, typeOverride)
+		sortSlice(result, less)
+
+		genResult := gopter.NewGenResult(result.Interface(), SortedSliceShrinker(elementShrinker, lessFn))
+		genResult.Sieve = func(v interface{}) bool {
+			return sortedSieve(less)(v) && (elementSieve == nil || forAllSieve(elementSieve)(v))
+		}
+		return genResult
+	}
+}
+
+// SortedSliceShrinker creates a shrinker for sorted slices (see SortedSliceOf) from a shrinker for
+// the elements of the slice.
+// The length of the slice will be shrinked as well, after an element is shrinked the slice is
+// sorted again.
+func SortedSliceShrinker(elementShrinker gopter.Shrinker, lessFn interface{}) gopter.Shrinker {
+	less := lessFunc(lessFn)
+	sliceShrinker := SliceShrinker(elementShrinker)
+	return func(v interface{}) gopter.Shrink {
+		return sliceShrinker(v).Map(func(shrunk interface{}) interface{} {
+			sortSlice(reflect.ValueOf(shrunk), less)
+			return shrunk
+		})
+	}
+}
+
+// lessFunc converts a less function (see SortedSliceOf) to a func(interface{}, interface{}) bool,
+// nil is converted to the natural order
+func lessFunc(lessFn interface{}) func(interface{}, interface{}) bool {
+	if lessFn == nil {
+		return naturalLess
+	}
+	lessVal := reflect.ValueOf(lessFn)
+	lessType := lessVal.Type()
+
+	if lessVal.Kind() != reflect.Func {
+		panic(fmt.Sprintf("Less function has to be a func, but is %v", lessType.Kind()))
+	}
+	if lessType.NumIn() != 2 {
+		panic(fmt.Sprintf("Less function has to be a func with two params, but is %v", lessType.NumIn()))
+	}
+	if lessType.NumOut() != 1 || lessType.Out(0).Kind() != reflect.Bool {
+		panic(fmt.Sprintf("Less function has to be a func with one return value of bool, but is %v", lessType))
+	}
+	return func(a, b interface{}) bool {
+		va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+		if !va.IsValid() {
+			va = reflect.Zero(lessType.In(0))
+		}
+		if !vb.IsValid() {
+			vb = reflect.Zero(lessType.In(1))
+		}
+		return lessVal.Call([]reflect.Value{va, vb})[0].Bool()
+	}
+}
+
+func hasNaturalOrder(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return true
+	}
+	return false
+}
+
+func naturalLess(a, b interface{}) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch va.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return va.Int() < vb.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return va.Uint() < vb.Uint()
+	case reflect.Float32, reflect.Float64:
+		return va.Float() < vb.Float()
+	case reflect.String:
+		return va.String() < vb.String()
+	}
+	panic(fmt.Sprintf("%v has no natural order", va.Type()))
+}
+
+func sortSlice(slice reflect.Value, less func(interface{}, interface{}) bool) {
+	sort.SliceStable(slice.Interface(), func(i, j int) bool {
+		return less(slice.Index(i).Interface(), slice.Index(j).Interface())
+	})
+}
+
+func sortedSieve(less func(interface{}, interface{}) bool) func(interface{}) bool {
+	return func(v interface{}) bool {
+		rv := reflect.ValueOf(v)
+		for i := 1; i < rv.Len(); i++ {
+			if less(rv.Index(i).Interface(), rv.Index(i-1).Interface()) {
+				return false
+			}
+		}
+		return true
+	}
+}
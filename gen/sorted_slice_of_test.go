@@ -0,0 +1,47 @@
+package gen_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/leanovate/gopter/gen"
+)
+
+func TestSortedSliceOf(t *testing.T) {
+	commonGeneratorTest(t, "sorted slice", gen.SortedSliceOf(gen.Int64(), nil), func(value interface{}) bool {
+		v, ok := value.([]int64)
+		return ok && sort.SliceIsSorted(v, func(i, j int) bool {
+			return v[i] < v[j]
+		})
+	})
+	commonGeneratorTest(t, "reverse sorted slice", gen.SortedSliceOf(gen.AlphaString(), func(a, b string) bool {
+		return a > b
+	}), func(value interface{}) bool {
+		v, ok := value.([]string)
+		return ok && sort.SliceIsSorted(v, func(i, j int) bool {
+			return v[i] > v[j]
+		})
+	})
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for elements without natural order")
+		}
+	}()
+	gen.SortedSliceOf(gen.Bool(), nil)
+}
+
+func TestSortedSliceShrinker(t *testing.T) {
+	shrinks := gen.SortedSliceShrinker(gen.Int64Shrinker, nil)([]int64{-3, 1}).All()
+	if !reflect.DeepEqual(shrinks, []interface{}{
+		[]int64{1},
+		[]int64{-3},
+		[]int64{0, 1},
+		[]int64{-2, 1},
+		[]int64{1, 2},
+		[]int64{-3, 0},
+	}) {
+		t.Errorf("Invalid shrinks: %#v", shrinks)
+	}
+}
@@ -16,16 +16,16 @@ func RuneRange(min, max rune) gopter.Gen {
 // Rune generates an arbitrary character rune
 func Rune() gopter.Gen {
 	return genRune(Frequency(map[int]gopter.Gen{
-		0xD800:                Int64Range(0, 0xD800),
-		utf8.MaxRune - 0xDFFF: Int64Range(0xDFFF, int64(utf8.MaxRune)),
+		0xD800:                Int64Range(0, 0xD7FF),
+		utf8.MaxRune - 0xDFFF: Int64Range(0xE000, int64(utf8.MaxRune)),
 	}))
 }
 
 // RuneNoControl generates an arbitrary character rune that is not a control character
 func RuneNoControl() gopter.Gen {
 	return genRune(Frequency(map[int]gopter.Gen{
-		0xD800:                Int64Range(32, 0xD800),
-		utf8.MaxRune - 0xDFFF: Int64Range(0xDFFF, int64(utf8.MaxRune)),
+		0xD800:                Int64Range(32, 0xD7FF),
+		utf8.MaxRune - 0xDFFF: Int64Range(0xE000, int64(utf8.MaxRune)),
 	}))
 }
 
@@ -95,8 +95,18 @@ func UnicodeChar(table *unicode.RangeTable) gopter.Gen {
 }
 
 // AnyString generates an arbitrary string
+// Note: Edge cases include strings that are not valid UTF-8
 func AnyString() gopter.Gen {
-	return genString(Rune(), utf8.ValidRune)
+	return genString(Rune(), utf8.ValidRune).WithEdgeCases(
+		"",
+		"\x00",
+		"\xff",         // invalid start byte
+		"\xc0\x80",     // overlong encoding
+		"\xed\xa0\x80", // utf-16 surrogate
+		"\xe2\x82",     // truncated sequence
+		"\uFFFD",       // replacement character
+		"\U0010FFFF",   // max rune
+	)
 }
 
 // AlphaString generates an arbitrary string with letters
@@ -159,3 +159,20 @@ func TestUnicodeString(t *testing.T) {
 		})
 	}
 }
+
+func TestAnyStringEdgeCases(t *testing.T) {
+	parameters := edgeCaseParameters()
+	anyStrings := gen.AnyString()
+	invalid := 0
+	for i := 0; i < 100; i++ {
+		value, ok := anyStrings(parameters).Retrieve()
+		if !ok {
+			t.Errorf("Invalid edge case: %#v", value)
+		} else if !utf8.ValidString(value.(string)) {
+			invalid++
+		}
+	}
+	if invalid == 0 {
+		t.Error("Invalid UTF-8 never generated")
+	}
+}
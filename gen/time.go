@@ -1,37 +1,195 @@
 package gen
 
 import (
+	"math"
+	"reflect"
 	"time"
 
 	"github.com/leanovate/gopter"
 )
 
-// Time generates an arbitrary time.Time within year [0, 9999]
+// Time generates an arbitrary time.Time in UTC within year [1970, 9999]
+// Values are shrinked toward simple times (like midnight) first and then toward the Unix epoch.
 func Time() gopter.Gen {
 	return func(genParams *gopter.GenParameters) *gopter.GenResult {
-		sec := genParams.Rng.Int63n(253402214400) // Ensure year in [0, 9999]
+		sec := genParams.Rng.Int63n(253402214400) // Ensure year in [1970, 9999]
 		usec := genParams.Rng.Int63n(1000000000)
 
-		return gopter.NewGenResult(time.Unix(sec, usec), TimeShrinker)
+		return gopter.NewGenResult(time.Unix(sec, usec).UTC(), utcTimeShrinker)
 	}
 }
 
-// AnyTime generates an arbitrary time.Time struct (might be way out of bounds of any reason)
+// AnyTime generates an arbitrary time.Time struct in UTC (might be way out of bounds of any reason)
+// Values are shrinked like the values of Time.
 func AnyTime() gopter.Gen {
 	return func(genParams *gopter.GenParameters) *gopter.GenResult {
 		sec := genParams.NextInt64()
 		usec := genParams.NextInt64()
 
-		return gopter.NewGenResult(time.Unix(sec, usec), TimeShrinker)
+		return gopter.NewGenResult(time.Unix(sec, usec).UTC(), utcTimeShrinker)
 	}
 }
 
 // TimeRange generates an arbitrary time.Time with a range
 // from defines the start of the time range
 // duration defines the overall duration of the time range
+// Values are shrinked toward simple times within the range (see TimeRangeShrinker)
 func TimeRange(from time.Time, duration time.Duration) gopter.Gen {
 	return func(genParams *gopter.GenParameters) *gopter.GenResult {
 		v := from.Add(time.Duration(genParams.Rng.Int63n(int64(duration))))
-		return gopter.NewGenResult(v, TimeShrinker)
+		return gopter.NewGenResult(v, TimeRangeShrinker(from, from.Add(duration)))
 	}
 }
+
+// Duration generates an arbitrary time.Duration
+func Duration() gopter.Gen {
+	return DurationRange(math.MinInt64, math.MaxInt64)
+}
+
+// DurationRange generates a time.Duration within [min, max].
+// Values are shrinked toward 0 or the bound of the range closest to 0.
+func DurationRange(min, max time.Duration) gopter.Gen {
+	if max < min {
+		return Fail(reflect.TypeOf(time.Duration(0)))
+	}
+	target := time.Duration(0)
+	if min > 0 {
+		target = min
+	} else if max < 0 {
+		target = max
+	}
+	int64Gen := Int64Range(int64(min), int64(max))
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		value, ok := int64Gen(genParams).Retrieve()
+		if !ok {
+			return gopter.NewEmptyResult(reflect.TypeOf(time.Duration(0)))
+		}
+		genResult := gopter.NewGenResult(time.Duration(value.(int64)), func(v interface{}) gopter.Shrink {
+			return shrinkTowards(int64(v.(time.Duration)), int64(target)).Map(int64ToDuration)
+		})
+		genResult.Sieve = func(v interface{}) bool {
+			return v.(time.Duration) >= min && v.(time.Duration) <= max
+		}
+		return genResult
+	}
+}
+
+// Month generates an arbitrary time.Month, shrinked toward January
+func Month() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		month := time.Month(1 + genParams.Rng.Intn(12))
+		return gopter.NewGenResult(month, func(v interface{}) gopter.Shrink {
+			return shrinkTowards(int64(v.(time.Month)), int64(time.January)).Map(func(v int64) time.Month {
+				return time.Month(v)
+			})
+		})
+	}
+}
+
+// Weekday generates an arbitrary time.Weekday, shrinked toward Sunday
+func Weekday() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		weekday := time.Weekday(genParams.Rng.Intn(7))
+		return gopter.NewGenResult(weekday, func(v interface{}) gopter.Shrink {
+			return shrinkTowards(int64(v.(time.Weekday)), int64(time.Sunday)).Map(func(v int64) time.Weekday {
+				return time.Weekday(v)
+			})
+		})
+	}
+}
+
+// calendarEdgeYears are years with special leap year rules (or other significance)
+var calendarEdgeYears = []int{1600, 1900, 1970, 2000, 2038, 2100, 2400}
+
+// CalendarBoundary generates times in a location close to the beginning of a month,
+// i.e. within a day around the end of a month, a year or a leap day, in a year
+// within [1, 9999]. (The boundaries are taken from the years [1, 9998], so that the end
+// of December does not end up in year 10000.)
+// Values are shrinked toward the boundary.
+func CalendarBoundary(location *time.Location) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		year := 1 + genParams.Rng.Intn(9998)
+		if idx, ok := genParams.NextEdgeCase(len(calendarEdgeYears)); ok {
+			year = calendarEdgeYears[idx]
+		}
+		month := time.Month(1 + genParams.Rng.Intn(12))
+		if idx, ok := genParams.NextEdgeCase(2); ok {
+			month = []time.Month{time.February, time.December}[idx]
+		}
+		// start of the following month
+		boundary := time.Date(year, month+1, 1, 0, 0, 0, 0, location)
+		return timeAround(boundary, timeOffset(genParams, 24*time.Hour))
+	}
+}
+
+// DSTTransition generates times in a location within two hours around a change of
+// the zone offset (e.g. because of daylight saving time) in a year within [1970, 2037].
+// Values are shrinked toward the transition.
+// Note: For locations without any transitions in the chosen years (like UTC, Asia/Tokyo or
+// Asia/Kolkata) arbitrary times of a year in the location are generated instead (shrinked toward
+// the start of the year), i.e. the generator can be used with any location (e.g. of
+// DefaultLocationNames).
+func DSTTransition(location *time.Location) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		year := 1970 + genParams.Rng.Intn(68)
+		for attempt := 0; attempt < 10; attempt++ {
+			transitions := zoneTransitions(location, year)
+			if len(transitions) > 0 {
+				transition := transitions[genParams.Rng.Intn(len(transitions))]
+				return timeAround(transition, timeOffset(genParams, 2*time.Hour))
+			}
+			year = 1970 + genParams.Rng.Intn(68)
+		}
+		start := time.Date(year, time.January, 1, 0, 0, 0, 0, location)
+		return timeAround(start, time.Duration(genParams.Rng.Int63n(int64(start.AddDate(1, 0, 0).Sub(start)))))
+	}
+}
+
+// zoneTransitions finds all changes of the zone offset of a location within a year
+func zoneTransitions(location *time.Location, year int) []time.Time {
+	transitions := []time.Time{}
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, location)
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, location)
+	_, offset := start.Zone()
+	for day := start; day.Before(end); {
+		next := day.Add(24 * time.Hour)
+		if _, nextOffset := next.Zone(); nextOffset != offset {
+			// bisect to find the first second with the new offset
+			lo, hi := day.Unix(), next.Unix()
+			for hi-lo > 1 {
+				mid := lo + (hi-lo)/2
+				if _, midOffset := time.Unix(mid, 0).In(location).Zone(); midOffset == offset {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			transitions = append(transitions, time.Unix(hi, 0).In(location))
+			offset = nextOffset
+		}
+		day = next
+	}
+	return transitions
+}
+
+// timeOffset generates an offset within [-window, window], with a bias toward 0 and -1ns
+func timeOffset(genParams *gopter.GenParameters, window time.Duration) time.Duration {
+	if idx, ok := genParams.NextEdgeCase(2); ok {
+		return []time.Duration{0, -time.Nanosecond}[idx]
+	}
+	return time.Duration(genParams.Rng.Int63n(2*int64(window)+1)) - window
+}
+
+// timeAround creates the result for a time close to a point in time, that is shrinked
+// toward the point in time itself
+func timeAround(center time.Time, offset time.Duration) *gopter.GenResult {
+	return gopter.NewGenResult(center.Add(offset), func(v interface{}) gopter.Shrink {
+		return shrinkTowards(int64(v.(time.Time).Sub(center)), 0).Map(func(d int64) time.Time {
+			return center.Add(time.Duration(d))
+		})
+	})
+}
+
+func int64ToDuration(v int64) time.Duration {
+	return time.Duration(v)
+}
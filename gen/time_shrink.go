@@ -25,3 +25,86 @@ func TimeShrinker(v interface{}) gopter.Shrink {
 		return time.Unix(sec, int64(v))
 	}))
 }
+
+// TimeRangeShrinker creates a shrinker for time.Time structs within [from, until].
+// The shrinker tries simpler times first, i.e. the same time in UTC, midnight or the
+// start of the hour, minute or second, before shrinking toward from.
+func TimeRangeShrinker(from, until time.Time) gopter.Shrinker {
+	inRange := func(v interface{}) bool {
+		t := v.(time.Time)
+		return !t.Before(from) && !t.After(until)
+	}
+	return func(v interface{}) gopter.Shrink {
+		t := v.(time.Time)
+		location := t.Location()
+		return gopter.ConcatShrinks(
+			shrinkValues(simplerTimes(t, inRange)),
+			shrinkTowards(int64(t.Sub(from)), 0).Map(func(d int64) time.Time {
+				return from.Add(time.Duration(d)).In(location)
+			}).Filter(inRange),
+		)
+	}
+}
+
+// utcTimeShrinker shrinks times in UTC like TimeShrinker, but tries the simpler
+// times of TimeRangeShrinker first
+func utcTimeShrinker(v interface{}) gopter.Shrink {
+	return gopter.ConcatShrinks(
+		shrinkValues(simplerTimes(v.(time.Time), func(interface{}) bool { return true })),
+		TimeShrinker(v).Map(func(t time.Time) time.Time {
+			return t.UTC()
+		}),
+	)
+}
+
+// simplerTimes creates the times before t that are simpler, i.e. the same time in UTC,
+// midnight or the start of the hour, minute or second
+func simplerTimes(t time.Time, inRange func(interface{}) bool) []interface{} {
+	location := t.Location()
+	simpler := []interface{}{}
+	if location != time.UTC {
+		simpler = append(simpler, t.UTC())
+	}
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	for _, candidate := range []time.Time{
+		time.Date(year, month, day, 0, 0, 0, 0, location),
+		time.Date(year, month, day, hour, 0, 0, 0, location),
+		time.Date(year, month, day, hour, min, 0, 0, location),
+		time.Date(year, month, day, hour, min, sec, 0, location),
+	} {
+		if candidate.Before(t) && inRange(candidate) &&
+			(len(simpler) == 0 || !candidate.Equal(simpler[len(simpler)-1].(time.Time))) {
+			simpler = append(simpler, candidate)
+		}
+	}
+	return simpler
+}
+
+// shrinkValues is a shrink over a fixed list of values
+func shrinkValues(values []interface{}) gopter.Shrink {
+	return func() (interface{}, bool) {
+		if len(values) == 0 {
+			return nil, false
+		}
+		value := values[0]
+		values = values[1:]
+		return value, true
+	}
+}
+
+// shrinkTowards shrinks an int64 toward a target (instead of 0)
+func shrinkTowards(value, target int64) gopter.Shrink {
+	if value >= target {
+		distance := uint64(value) - uint64(target)
+		shrink := uint64Shrink{original: distance, half: distance}
+		return gopter.Shrink(shrink.Next).Map(func(d uint64) int64 {
+			return int64(uint64(target) + d)
+		})
+	}
+	distance := uint64(target) - uint64(value)
+	shrink := uint64Shrink{original: distance, half: distance}
+	return gopter.Shrink(shrink.Next).Map(func(d uint64) int64 {
+		return int64(uint64(target) - d)
+	})
+}
@@ -25,3 +25,32 @@ func TestTimeShrink(t *testing.T) {
 	}
 
 }
+
+func TestTimeRangeShrink(t *testing.T) {
+	location, _ := time.LoadLocation("Asia/Tokyo")
+	from := time.Date(2020, time.March, 1, 12, 0, 0, 0, time.UTC)
+	until := from.AddDate(1, 0, 0)
+	value := time.Date(2020, time.July, 14, 15, 16, 17, 18, location)
+
+	timeShrink := gen.TimeRangeShrinker(from, until)(value).All()
+	expected := []interface{}{
+		value.UTC(),
+		time.Date(2020, time.July, 14, 0, 0, 0, 0, location),
+		time.Date(2020, time.July, 14, 15, 0, 0, 0, location),
+		time.Date(2020, time.July, 14, 15, 16, 0, 0, location),
+		time.Date(2020, time.July, 14, 15, 16, 17, 0, location),
+		from.In(location),
+	}
+	if len(timeShrink) < len(expected) || !reflect.DeepEqual(timeShrink[:len(expected)], expected) {
+		t.Errorf("Invalid timeShrink: %#v", timeShrink)
+	}
+	for _, shrunk := range timeShrink {
+		if shrunk.(time.Time).Before(from) || shrunk.(time.Time).After(until) {
+			t.Errorf("Shrunk value out of range: %v", shrunk)
+		}
+	}
+
+	if timeShrink := gen.TimeRangeShrinker(from, until)(from).All(); len(timeShrink) != 0 {
+		t.Errorf("Invalid timeShrink: %#v", timeShrink)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
 )
 
@@ -19,10 +20,23 @@ func TestTime(t *testing.T) {
 		if !ok || v.String() == "" {
 			t.Errorf("Invalid time: %#v", value)
 		}
-		if v.Year() < 0 || v.Year() > 9999 {
+		if v.Year() < 1970 || v.Year() > 9999 || v.Location() != time.UTC {
 			t.Errorf("Year out of range: %#v", v)
 		}
 	}
+
+	result := timeGen(gopter.DefaultGenParameters())
+	value, _ := result.Retrieve()
+	v := value.(time.Time)
+	timeShrink := result.Shrinker(value).All()
+	if len(timeShrink) == 0 || !timeShrink[0].(time.Time).Equal(time.Date(v.Year(), v.Month(), v.Day(), 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Time not shrunk toward midnight: %v -> %v", v, timeShrink)
+	}
+	for _, shrunk := range timeShrink {
+		if shrunk.(time.Time).Location() != time.UTC || !shrunk.(time.Time).Before(v) {
+			t.Errorf("Invalid shrunk time: %v -> %v", v, shrunk)
+		}
+	}
 }
 
 func TestAnyTime(t *testing.T) {
@@ -34,7 +48,7 @@ func TestAnyTime(t *testing.T) {
 			t.Errorf("Invalid time: %#v", value)
 		}
 		v, ok := value.(time.Time)
-		if !ok || v.String() == "" {
+		if !ok || v.String() == "" || v.Location() != time.UTC {
 			t.Errorf("Invalid time: %#v", value)
 		}
 	}
@@ -58,3 +72,82 @@ func TestTimeRegion(t *testing.T) {
 		}
 	}
 }
+
+func TestDurationRange(t *testing.T) {
+	commonGeneratorTest(t, "duration range", gen.DurationRange(time.Second, time.Hour), func(value interface{}) bool {
+		v, ok := value.(time.Duration)
+		return ok && v >= time.Second && v <= time.Hour
+	})
+	commonGeneratorTest(t, "duration", gen.Duration(), func(value interface{}) bool {
+		_, ok := value.(time.Duration)
+		return ok
+	})
+	if value, ok := gen.DurationRange(time.Hour, time.Second).Sample(); ok {
+		t.Errorf("Invalid value for empty range: %#v", value)
+	}
+
+	result := gen.DurationRange(time.Second, time.Hour)(gopter.DefaultGenParameters().CloneWithSeed(1234))
+	value, _ := result.Retrieve()
+	if value != time.Duration(2943440943740) {
+		t.Errorf("Unexpected duration for seed 1234: %#v", value)
+	}
+	if shrunk, ok := result.Shrinker(value)(); !ok || shrunk != time.Second {
+		t.Errorf("Duration not shrunk toward lower bound: %#v -> %#v", value, shrunk)
+	}
+}
+
+func TestMonthWeekday(t *testing.T) {
+	commonGeneratorTest(t, "month", gen.Month(), func(value interface{}) bool {
+		v, ok := value.(time.Month)
+		return ok && v >= time.January && v <= time.December
+	})
+	commonGeneratorTest(t, "weekday", gen.Weekday(), func(value interface{}) bool {
+		v, ok := value.(time.Weekday)
+		return ok && v >= time.Sunday && v <= time.Saturday
+	})
+}
+
+func TestCalendarBoundary(t *testing.T) {
+	location, _ := time.LoadLocation("Europe/Berlin")
+	leapDays := 0
+	parameters := gopter.DefaultGenParameters()
+	for i := 0; i < 1000; i++ {
+		value, ok := gen.CalendarBoundary(location)(parameters).Retrieve()
+		if !ok {
+			t.Fatalf("Invalid value: %#v", value)
+		}
+		v := value.(time.Time)
+		if v.Location() != location || (v.Day() != 1 && v.AddDate(0, 0, 1).Day() != 1 && v.AddDate(0, 0, 2).Day() != 1) {
+			t.Errorf("Not close to a month boundary: %v", v)
+		}
+		if v.Year() < 1 || v.Year() > 9999 {
+			t.Errorf("Year out of range: %v", v)
+		}
+		if v.Month() == time.February && v.Day() == 29 {
+			leapDays++
+		}
+	}
+	if leapDays == 0 {
+		t.Error("No leap days generated")
+	}
+}
+
+func TestDSTTransition(t *testing.T) {
+	location, _ := time.LoadLocation("America/New_York")
+	commonGeneratorTest(t, "dst transition", gen.DSTTransition(location), func(value interface{}) bool {
+		v, ok := value.(time.Time)
+		if !ok {
+			return false
+		}
+		_, before := v.Add(-2*time.Hour - time.Second).Zone()
+		_, after := v.Add(2*time.Hour + time.Second).Zone()
+		return v.Location() == location && before != after
+	})
+	for _, name := range []string{"UTC", "Asia/Tokyo", "Asia/Kolkata"} {
+		location, _ := time.LoadLocation(name)
+		commonGeneratorTest(t, "no dst transition "+name, gen.DSTTransition(location), func(value interface{}) bool {
+			v, ok := value.(time.Time)
+			return ok && v.Location() == location && v.Year() >= 1970 && v.Year() <= 2037
+		})
+	}
+}
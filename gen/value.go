@@ -0,0 +1,52 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/leanovate/gopter"
+)
+
+// WithValue creates a generator that runs gen with an additional entry in the
+// user-defined environment of the generator parameters (see gopter.GenParameters.WithValue).
+// The entry is only visible to gen and its nested generators, i.e. it may be used to
+// override a value for a subtree of generators.
+func WithValue(key, value interface{}, gen gopter.Gen) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		return gen(genParams.WithValue(key, value))
+	}
+}
+
+// FromValue creates a generator that depends on a value of the user-defined environment
+// of the generator parameters (see gopter.GenParameters.WithValue).
+// f: has to be a function with one parameter and a gopter.Gen as single return. f is invoked
+// with the value associated with key every time a value is generated, the zero value of the
+// parameter type if there is none.
+// Note: FromValue panics if the value associated with key is not assignable to the parameter of f.
+func FromValue(key interface{}, f interface{}) gopter.Gen {
+	fVal := reflect.ValueOf(f)
+	fType := fVal.Type()
+
+	if fVal.Kind() != reflect.Func {
+		panic(fmt.Sprintf("Param of FromValue has to be a func, but is %v", fType.Kind()))
+	}
+	if fType.NumIn() != 1 {
+		panic(fmt.Sprintf("Param of FromValue has to be a func with one param, but is %v", fType.NumIn()))
+	}
+	if fType.NumOut() != 1 || fType.Out(0) != reflect.TypeOf(gopter.Gen(nil)) {
+		panic("Param of FromValue has to be a func with a gopter.Gen as single return")
+	}
+	paramType := fType.In(0)
+
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		param := reflect.Zero(paramType)
+		if value, ok := genParams.LookupValue(key); ok && value != nil {
+			param = reflect.ValueOf(value)
+			if !param.Type().AssignableTo(paramType) {
+				panic(fmt.Sprintf("Value of %v is a %v, which is not assignable to %v", key, param.Type(), paramType))
+			}
+		}
+		gen := fVal.Call([]reflect.Value{param})[0].Interface().(gopter.Gen)
+		return gen(genParams)
+	}
+}
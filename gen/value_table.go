@@ -0,0 +1,81 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// maxTableValues limits the number of values remembered by a generation of a valueTable
+const maxTableValues = 1 << 12
+
+// valueTable remembers how the values of a generator have been created (including the shrinked
+// ones). The table is shared by all results of the generator, so that a value is shrinked
+// independent of the result it stems from (e.g. the elements of a SliceOf). Only the most
+// recently used values are remembered: Once the current generation is full it replaces the
+// previous one.
+type valueTable struct {
+	mutex    sync.Mutex
+	current  map[interface{}]interface{}
+	previous map[interface{}]interface{}
+}
+
+func newValueTable() *valueTable {
+	return &valueTable{
+		current: map[interface{}]interface{}{},
+	}
+}
+
+func (t *valueTable) put(value, entry interface{}) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.store(tableKey(value), entry)
+}
+
+func (t *valueTable) store(key, entry interface{}) {
+	if len(t.current) >= maxTableValues {
+		t.previous, t.current = t.current, map[interface{}]interface{}{}
+	}
+	t.current[key] = entry
+}
+
+func (t *valueTable) get(value interface{}) (interface{}, bool) {
+	key := tableKey(value)
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if entry, ok := t.current[key]; ok {
+		return entry, true
+	}
+	if entry, ok := t.previous[key]; ok {
+		t.store(key, entry)
+		return entry, true
+	}
+	return nil, false
+}
+
+// reprKey is the key of a value that can not be used as map key itself
+type reprKey string
+
+// tableKey creates a map key for a value. Values that are not comparable or not equal
+// to themselves (e.g. slices, NaN or funcs) are represented by their formatted value.
+func tableKey(v interface{}) (key interface{}) {
+	if v == nil {
+		return nil
+	}
+	if reflect.TypeOf(v).Comparable() {
+		defer func() {
+			// comparable types may contain interfaces with non-comparable values
+			if recover() != nil {
+				key = reprKey(fmt.Sprintf("%T %#v", v, v))
+			}
+		}()
+		if equalInterfaces(v, v) {
+			return v
+		}
+	}
+	return reprKey(fmt.Sprintf("%T %#v", v, v))
+}
+
+func equalInterfaces(a, b interface{}) bool {
+	return a == b
+}
@@ -0,0 +1,67 @@
+package gen_test
+
+import (
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+type catalogKey struct{}
+
+func idGen() gopter.Gen {
+	return gen.FromValue(catalogKey{}, func(ids []string) gopter.Gen {
+		if len(ids) == 0 {
+			return gen.Const("none")
+		}
+		consts := make([]interface{}, len(ids))
+		for i, id := range ids {
+			consts[i] = id
+		}
+		return gen.OneConstOf(consts...)
+	})
+}
+
+func TestWithValue(t *testing.T) {
+	params := gopter.DefaultGenParameters()
+	if value, ok := idGen()(params).Retrieve(); !ok || value != "none" {
+		t.Errorf("Invalid value without environment: %#v", value)
+	}
+
+	ids := gen.WithValue(catalogKey{}, []string{"id1"}, gen.SliceOfN(3, gen.OneGenOf(
+		idGen(),
+		gen.WithValue(catalogKey{}, []string{"id2"}, idGen()),
+	)))
+	for i := 0; i < 100; i++ {
+		value, ok := ids(params).Retrieve()
+		if !ok {
+			t.Fatalf("Invalid result: %#v", value)
+		}
+		for _, id := range value.([]string) {
+			if id != "id1" && id != "id2" {
+				t.Errorf("Invalid id: %#v", id)
+			}
+		}
+	}
+	if params.Value(catalogKey{}) != nil {
+		t.Error("Environment of parameters modified")
+	}
+
+	parameters := gopter.DefaultTestParameters().WithValue(catalogKey{}, []string{"id3"})
+	result := prop.ForAll(func(id string) bool {
+		return id == "id3"
+	}, idGen()).Check(parameters)
+	if !result.Passed() {
+		t.Errorf("Environment of test parameters not passed to generators: %#v", result)
+	}
+}
+
+func TestFromValueInvalid(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("FromValue did not panic for a value of the wrong type")
+		}
+	}()
+	gen.WithValue(catalogKey{}, 1, idGen()).Sample()
+}
@@ -17,6 +17,8 @@ type WeightedGen struct {
 // Weighted combines multiple generators, where each generator has a weight.
 // The weight of a generator is proportional to the probability that the
 // generator gets selected.
+// Values are shrinked toward (simple) values of the generators earlier in the list,
+// then by the shrinker of the generator the value was taken from.
 func Weighted(weightedGens []WeightedGen) gopter.Gen {
 	if len(weightedGens) == 0 {
 		panic("weightedGens must be non-empty")
@@ -34,11 +36,13 @@ func Weighted(weightedGens []WeightedGen) gopter.Gen {
 		totalWeight += weightedGen.Weight
 		weights = append(weights, totalWeight)
 	}
+	gens := make([]gopter.Gen, len(weightedGens))
+	for i, weightedGen := range weightedGens {
+		gens[i] = weightedGen.Gen
+	}
+	a := newAlternatives(gens)
 	return func(genParams *gopter.GenParameters) *gopter.GenResult {
 		idx := weights.Search(1 + genParams.Rng.Intn(totalWeight))
-		gen := weightedGens[idx].Gen
-		result := gen(genParams)
-		result.Sieve = nil
-		return result
+		return a.result(idx, genParams)
 	}
 }
@@ -3,7 +3,9 @@ package gen_test
 import (
 	"testing"
 
+	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
 )
 
 func TestWeighted(t *testing.T) {
@@ -39,3 +41,16 @@ func TestWeighted(t *testing.T) {
 		}
 	}
 }
+
+func TestWeightedShrink(t *testing.T) {
+	weighted := gen.Weighted([]gen.WeightedGen{
+		{Weight: 1, Gen: gen.Const("simple")},
+		{Weight: 1, Gen: gen.AlphaString().SuchThat(func(s string) bool { return len(s) > 5 })},
+	})
+	result := prop.ForAll(func(s string) bool {
+		return s != "simple" && len(s) < 10
+	}, weighted).Check(gopter.DefaultTestParameters())
+	if result.Passed() || len(result.Args) != 1 || result.Args[0].Arg != "simple" {
+		t.Errorf("Invalid result: %#v", result)
+	}
+}
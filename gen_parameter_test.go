@@ -1,10 +1,13 @@
 package gopter_test
 
 import (
+	"fmt"
 	"math/rand"
+	"sync"
 	"testing"
 
 	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
 )
 
 type fixedSeed struct {
@@ -61,3 +64,84 @@ func TestGenParameters(t *testing.T) {
 		}
 	}
 }
+
+func TestGenParametersNextEdgeCase(t *testing.T) {
+	parameters := gopter.DefaultGenParameters()
+
+	parameters.EdgeCaseProbability = 0
+	for i := 0; i < 100; i++ {
+		if _, ok := parameters.NextEdgeCase(3); ok {
+			t.Error("edge case should be disabled")
+		}
+	}
+
+	parameters.EdgeCaseProbability = 1
+	seen := map[int]bool{}
+	for i := 0; i < 100; i++ {
+		idx, ok := parameters.NextEdgeCase(3)
+		if !ok || idx < 0 || idx >= 3 {
+			t.Errorf("Invalid edge case: %d %v", idx, ok)
+		}
+		seen[idx] = true
+	}
+	if len(seen) != 3 {
+		t.Errorf("Not all edge cases used: %#v", seen)
+	}
+	if _, ok := parameters.NextEdgeCase(0); ok {
+		t.Error("there should be no edge case without candidates")
+	}
+}
+
+type envKey string
+
+func TestGenParametersValue(t *testing.T) {
+	parameters := gopter.DefaultGenParameters()
+	if parameters.Value(envKey("a")) != nil {
+		t.Error("Empty environment should not have values")
+	}
+
+	withA := parameters.WithValue(envKey("a"), 1)
+	withB := withA.WithValue(envKey("b"), 2).WithValue(envKey("a"), 3)
+	if withA.Value(envKey("a")) != 1 || withA.Value(envKey("b")) != nil {
+		t.Errorf("Invalid environment: %v %v", withA.Value(envKey("a")), withA.Value(envKey("b")))
+	}
+	if withB.Value(envKey("a")) != 3 || withB.Value(envKey("b")) != 2 {
+		t.Errorf("Invalid overridden environment: %v %v", withB.Value(envKey("a")), withB.Value(envKey("b")))
+	}
+	if value, ok := withB.WithSize(10).CloneWithSeed(1).LookupValue(envKey("b")); !ok || value != 2 {
+		t.Errorf("Environment not retained: %v", value)
+	}
+	if _, ok := withA.WithValue(envKey("c"), nil).LookupValue(envKey("c")); !ok {
+		t.Error("nil value should be present")
+	}
+	if parameters.Value(envKey("a")) != nil {
+		t.Error("Environment of original parameters modified")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("WithValue did not panic for a non comparable key")
+		}
+	}()
+	parameters.WithValue([]string{}, 1)
+}
+
+func TestDefaultGenParamsConcurrent(t *testing.T) {
+	// generators probe DefaultGenParams for their result type, which must be safe from
+	// several goroutines (see go test -race)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				gen.Int().Map(func(v int) string {
+					return fmt.Sprint(v)
+				}).SuchThat(func(string) bool {
+					return true
+				})
+			}
+		}()
+	}
+	wg.Wait()
+}
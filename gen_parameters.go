@@ -2,15 +2,55 @@ package gopter
 
 import (
 	"math/rand"
+	"reflect"
 	"time"
 )
 
+// DefaultEdgeCaseProbability is the probability of edge cases used by the default parameters.
+const DefaultEdgeCaseProbability = 0.05
+
 // GenParameters encapsulates the parameters for all generators.
 type GenParameters struct {
 	MinSize        int
 	MaxSize        int
 	MaxShrinkCount int
-	Rng            *rand.Rand
+	// EdgeCaseProbability is the probability that a generator produces one of
+	// its edge cases (i.e. boundary values like 0, min, max or the empty slice)
+	// instead of a uniformly drawn value. A value <= 0 disables edge cases.
+	EdgeCaseProbability float64
+	Rng                 *rand.Rand
+	// values is the user-defined environment (see WithValue)
+	values *genValue
+	// source is the splittable source of Rng (if there is one)
+	source    SplittableSource
+	sourceRng *rand.Rand
+}
+
+// genValue is a single entry of the user-defined environment of the GenParameters.
+// Like a context.Context the environment is a linked list, so that an overridden
+// value is only visible to the generators using the derived parameters.
+type genValue struct {
+	parent     *genValue
+	key, value interface{}
+}
+
+func (v *genValue) lookup(key interface{}) (interface{}, bool) {
+	for ; v != nil; v = v.parent {
+		if v.key == key {
+			return v.value, true
+		}
+	}
+	return nil, false
+}
+
+func newGenValue(parent *genValue, key, value interface{}) *genValue {
+	if key == nil {
+		panic("nil key")
+	}
+	if !reflect.TypeOf(key).Comparable() {
+		panic("key is not comparable")
+	}
+	return &genValue{parent: parent, key: key, value: value}
 }
 
 // WithSize modifies the size parameter. The size parameter defines an upper bound for the size of
@@ -21,6 +61,51 @@ func (p *GenParameters) WithSize(size int) *GenParameters {
 	return &newParameters
 }
 
+// WithValue creates a copy of the parameters with an additional entry in the
+// user-defined environment, e.g. configuration shared by nested generators.
+// Like for context.WithValue the key has to be comparable and should be of an
+// unexported type to avoid collisions. A value with the same key is overridden
+// for all generators using the derived parameters.
+func (p *GenParameters) WithValue(key, value interface{}) *GenParameters {
+	newParameters := *p
+	newParameters.values = newGenValue(p.values, key, value)
+	return &newParameters
+}
+
+// Value returns the value associated with key in the user-defined environment,
+// nil if there is none.
+func (p *GenParameters) Value(key interface{}) interface{} {
+	value, _ := p.values.lookup(key)
+	return value
+}
+
+// LookupValue returns the value associated with key in the user-defined environment
+// and whether the key is present.
+func (p *GenParameters) LookupValue(key interface{}) (interface{}, bool) {
+	return p.values.lookup(key)
+}
+
+// WithSource creates a copy of the parameters using a different source of random numbers.
+// If source is a SplittableSource, it is used by Split to create independent streams.
+func (p *GenParameters) WithSource(source rand.Source) *GenParameters {
+	newParameters := *p
+	newParameters.Rng = rand.New(source)
+	newParameters.source, _ = source.(SplittableSource)
+	newParameters.sourceRng = newParameters.Rng
+	return &newParameters
+}
+
+// Split creates a copy of the parameters with an independent (deterministic) stream of
+// random numbers, e.g. for a different worker or a nested generator.
+// If the source of Rng is not a SplittableSource (e.g. after Rng has been replaced), the
+// new stream is seeded with a random number of Rng.
+func (p *GenParameters) Split() *GenParameters {
+	if p.source != nil && p.sourceRng == p.Rng {
+		return p.WithSource(p.source.Split())
+	}
+	return p.WithSource(NewSplitMixSource(p.Rng.Int63()))
+}
+
 // NextBool create a random boolean using the underlying Rng.
 func (p *GenParameters) NextBool() bool {
 	return p.Rng.Int63()&1 == 0
@@ -43,26 +128,44 @@ func (p *GenParameters) NextUint64() uint64 {
 	return (first << 1) ^ second
 }
 
+// NextEdgeCase decides if a generator should produce one of its n edge cases
+// instead of a regular value. If so, the index of the edge case is returned.
+// No random numbers are consumed if edge cases are disabled.
+func (p *GenParameters) NextEdgeCase(n int) (int, bool) {
+	if n <= 0 || p.EdgeCaseProbability <= 0 {
+		return 0, false
+	}
+	if p.Rng.Float64() >= p.EdgeCaseProbability {
+		return 0, false
+	}
+	return p.Rng.Intn(n), true
+}
+
 // CloneWithSeed clone the current parameters with a new seed.
 // This is useful to create subsections that can rerun (provided you keep the
 // seed)
 func (p *GenParameters) CloneWithSeed(seed int64) *GenParameters {
-	return &GenParameters{
-		MinSize:        p.MinSize,
-		MaxSize:        p.MaxSize,
-		MaxShrinkCount: p.MaxShrinkCount,
-		Rng:            rand.New(NewLockedSource(seed)),
+	parameters := &GenParameters{
+		MinSize:             p.MinSize,
+		MaxSize:             p.MaxSize,
+		MaxShrinkCount:      p.MaxShrinkCount,
+		EdgeCaseProbability: p.EdgeCaseProbability,
+		values:              p.values,
 	}
+	return parameters.WithSource(NewSplitMixSource(seed))
 }
 
 // DefaultGenParameters creates default GenParameters.
+// The parameters use a locked source of random numbers, so that they may be shared by several
+// goroutines (like DefaultGenParams). Use WithSource or Split for a (lock-free) SplittableSource.
 func DefaultGenParameters() *GenParameters {
 	seed := time.Now().UnixNano()
 
-	return &GenParameters{
-		MinSize:        0,
-		MaxSize:        100,
-		MaxShrinkCount: 1000,
-		Rng:            rand.New(NewLockedSource(seed)),
+	parameters := &GenParameters{
+		MinSize:             0,
+		MaxSize:             100,
+		MaxShrinkCount:      1000,
+		EdgeCaseProbability: DefaultEdgeCaseProbability,
 	}
+	return parameters.WithSource(NewLockedSource(seed))
 }
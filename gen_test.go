@@ -1,10 +1,14 @@
 package gopter_test
 
 import (
+	"fmt"
+	"math"
 	"reflect"
 	"testing"
 
 	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
 )
 
 func constGen(value interface{}) gopter.Gen {
@@ -48,6 +52,29 @@ func BenchmarkMap(b *testing.B) {
 	}
 }
 
+func TestGenWithEdgeCases(t *testing.T) {
+	gen := constGen("sample").SuchThat(func(v string) bool {
+		return v != "invalid"
+	}).WithEdgeCases("edge")
+
+	parameters := gopter.DefaultGenParameters()
+	parameters.EdgeCaseProbability = 0
+	value, ok := gen(parameters).Retrieve()
+	if !ok || value != "sample" {
+		t.Errorf("Invalid gen sample: %#v", value)
+	}
+
+	parameters.EdgeCaseProbability = 1
+	result := gen(parameters)
+	value, ok = result.Retrieve()
+	if !ok || value != "edge" {
+		t.Errorf("Invalid edge case: %#v", value)
+	}
+	if result.Sieve == nil || result.Sieve("invalid") {
+		t.Error("Sieve of the edge case generator got lost")
+	}
+}
+
 func TestGenMap(t *testing.T) {
 	gen := constGen("sample")
 	var mappedWith string
@@ -101,6 +128,103 @@ func TestGenMapWithParams(t *testing.T) {
 	}
 }
 
+func TestGenMapShrink(t *testing.T) {
+	mapped := gen.IntRange(10, 1000).SuchThat(func(v int) bool {
+		return v%2 == 0
+	}).Map(func(v int) string {
+		return fmt.Sprintf("#%d", v)
+	})
+	genParams := gopter.DefaultGenParameters()
+	result := mapped(genParams)
+	value, ok := result.Retrieve()
+	for !ok {
+		result = mapped(genParams)
+		value, ok = result.Retrieve()
+	}
+	if result.Sieve == nil || !result.Sieve(value) {
+		t.Errorf("Sieve of source generator not retained: %#v", value)
+	}
+	shrink := result.Shrinker(value).Filter(result.Sieve)
+	for shrinked, ok := shrink(); ok; shrinked, ok = shrink() {
+		var v int
+		if _, err := fmt.Sscanf(shrinked.(string), "#%d", &v); err != nil || v < 10 || v%2 != 0 {
+			t.Errorf("Invalid shrinked value: %#v", shrinked)
+		}
+	}
+
+	parameters := gopter.DefaultTestParameters()
+	checkResult := prop.ForAll(func(v string) bool {
+		return len(v) < 4
+	}, mapped).Check(parameters)
+	if checkResult.Passed() || len(checkResult.Args) != 1 {
+		t.Fatalf("Invalid result: %#v", checkResult)
+	}
+	if shrinked := checkResult.Args[0].Arg.(string); len(shrinked) != 4 {
+		t.Errorf("Mapped value not shrinked in source domain: %#v", checkResult.Args[0].Arg)
+	}
+}
+
+// nanPair is not deeply equal to itself
+type nanPair struct {
+	N int
+	F float64
+}
+
+func TestGenMapShrinkNaN(t *testing.T) {
+	mapped := gen.IntRange(0, 1000).Map(func(v int) nanPair {
+		return nanPair{N: v, F: math.NaN()}
+	})
+	parameters := gopter.DefaultTestParameters()
+	checkResult := prop.ForAll(func(p nanPair) bool {
+		return p.N < 10
+	}, mapped).Check(parameters)
+	if checkResult.Passed() || len(checkResult.Args) != 1 {
+		t.Fatalf("Invalid result: %#v", checkResult)
+	}
+	if shrinked := checkResult.Args[0].Arg.(nanPair); shrinked.N != 10 {
+		t.Errorf("Mapped value not shrinked: %#v", checkResult.Args[0].Arg)
+	}
+}
+
+type myInt int
+
+func TestGenMapShrinkSliceElements(t *testing.T) {
+	mapped := gen.SliceOfN(5, gen.IntRange(0, 1000).Map(func(v int) myInt {
+		return myInt(v)
+	}))
+	parameters := gopter.DefaultTestParameters()
+	checkResult := prop.ForAll(func(vs []myInt) bool {
+		for _, v := range vs {
+			if v >= 500 {
+				return false
+			}
+		}
+		return true
+	}, mapped).Check(parameters)
+	if checkResult.Passed() || len(checkResult.Args) != 1 {
+		t.Fatalf("Invalid result: %#v", checkResult)
+	}
+	sum := myInt(0)
+	for _, v := range checkResult.Args[0].Arg.([]myInt) {
+		sum += v
+	}
+	if sum != 500 {
+		t.Errorf("Mapped elements not shrinked: %#v", checkResult.Args[0].Arg)
+	}
+}
+
+func TestGenMapWithParamsShrink(t *testing.T) {
+	mapped := gen.IntRange(0, 1000).Map(func(v int, params *gopter.GenParameters) string {
+		return fmt.Sprintf("%d-%d", v, params.Rng.Intn(1000))
+	})
+	result := mapped(gopter.DefaultGenParameters())
+	value, _ := result.Retrieve()
+	first := result.Shrinker(value).All()
+	if second := result.Shrinker(value).All(); !reflect.DeepEqual(first, second) {
+		t.Errorf("Shrinks not deterministic: %#v != %#v", first, second)
+	}
+}
+
 func TestGenMapNoFunc(t *testing.T) {
 	defer expectPanic(t, "Param of Map has to be a func, but is string")
 	constGen("sample").Map("not a function")
@@ -0,0 +1,98 @@
+package laws
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// Associative registers the law op(op(a, b), c) == op(a, op(b, c)).
+// op: has to be a func(T, T) T for the type T of the generated values.
+func Associative(properties *gopter.Properties, name string, gen gopter.Gen, op interface{}) {
+	o := newOperation("Operation", op, nil)
+	properties.Property(name+": associativity", prop.ForAll(func(a, b, c interface{}) string {
+		return compare("op(op(a, b), c)", o.apply(o.apply(a, b), c), "op(a, op(b, c))", o.apply(a, o.apply(b, c)))
+	}, gen, gen, gen))
+}
+
+// Commutative registers the law op(a, b) == op(b, a).
+// op: has to be a func(T, T) T for the type T of the generated values.
+func Commutative(properties *gopter.Properties, name string, gen gopter.Gen, op interface{}) {
+	o := newOperation("Operation", op, nil)
+	properties.Property(name+": commutativity", prop.ForAll(func(a, b interface{}) string {
+		return compare("op(a, b)", o.apply(a, b), "op(b, a)", o.apply(b, a))
+	}, gen, gen))
+}
+
+// Idempotent registers the law op(a, a) == a.
+// op: has to be a func(T, T) T for the type T of the generated values.
+func Idempotent(properties *gopter.Properties, name string, gen gopter.Gen, op interface{}) {
+	o := newOperation("Operation", op, nil)
+	properties.Property(name+": idempotence", prop.ForAll(func(a interface{}) string {
+		return compare("op(a, a)", o.apply(a, a), "a", a)
+	}, gen))
+}
+
+// Identity registers the laws op(identity, a) == a and op(a, identity) == a.
+// op: has to be a func(T, T) T for the type T of the generated values, identity has to be a T.
+func Identity(properties *gopter.Properties, name string, gen gopter.Gen, op interface{}, identity interface{}) {
+	o := newOperation("Operation", op, nil)
+	if identity != nil && !reflect.TypeOf(identity).AssignableTo(o.inType) {
+		panic(fmt.Sprintf("Identity has to be a %v, but is %T", o.inType, identity))
+	}
+	properties.Property(name+": left identity", prop.ForAll(func(a interface{}) string {
+		return compare("op(identity, a)", o.apply(identity, a), "a", a)
+	}, gen))
+	properties.Property(name+": right identity", prop.ForAll(func(a interface{}) string {
+		return compare("op(a, identity)", o.apply(a, identity), "a", a)
+	}, gen))
+}
+
+// Absorption registers the laws join(a, meet(a, b)) == a and meet(a, join(a, b)) == a.
+// join, meet: have to be a func(T, T) T for the type T of the generated values.
+func Absorption(properties *gopter.Properties, name string, gen gopter.Gen, join, meet interface{}) {
+	j := newOperation("Join", join, nil)
+	m := newOperation("Meet", meet, nil)
+	properties.Property(name+": absorption of meet", prop.ForAll(func(a, b interface{}) string {
+		return compare("join(a, meet(a, b))", j.apply(a, m.apply(a, b)), "a", a)
+	}, gen, gen))
+	properties.Property(name+": absorption of join", prop.ForAll(func(a, b interface{}) string {
+		return compare("meet(a, join(a, b))", m.apply(a, j.apply(a, b)), "a", a)
+	}, gen, gen))
+}
+
+// Semigroup registers the laws of a semigroup, i.e. op has to be associative.
+func Semigroup(properties *gopter.Properties, name string, gen gopter.Gen, op interface{}) {
+	Associative(properties, name, gen, op)
+}
+
+// Monoid registers the laws of a monoid, i.e. op has to be associative with an identity.
+func Monoid(properties *gopter.Properties, name string, gen gopter.Gen, op interface{}, identity interface{}) {
+	Semigroup(properties, name, gen, op)
+	Identity(properties, name, gen, op, identity)
+}
+
+// CommutativeMonoid registers the laws of a commutative monoid, i.e. op has to be associative and
+// commutative with an identity.
+func CommutativeMonoid(properties *gopter.Properties, name string, gen gopter.Gen, op interface{}, identity interface{}) {
+	Monoid(properties, name, gen, op, identity)
+	Commutative(properties, name, gen, op)
+}
+
+// Semilattice registers the laws of a semilattice, i.e. op has to be associative, commutative
+// and idempotent (as required for the merge of a state based CRDT).
+func Semilattice(properties *gopter.Properties, name string, gen gopter.Gen, op interface{}) {
+	Semigroup(properties, name, gen, op)
+	Commutative(properties, name, gen, op)
+	Idempotent(properties, name, gen, op)
+}
+
+// Lattice registers the laws of a lattice, i.e. join and meet have to be semilattices connected
+// by the absorption laws.
+func Lattice(properties *gopter.Properties, name string, gen gopter.Gen, join, meet interface{}) {
+	Semilattice(properties, name+" join", gen, join)
+	Semilattice(properties, name+" meet", gen, meet)
+	Absorption(properties, name, gen, join, meet)
+}
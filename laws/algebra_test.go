@@ -0,0 +1,134 @@
+package laws_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/laws"
+)
+
+// resultReporter collects the results of all properties by name
+type resultReporter map[string]*gopter.TestResult
+
+func (r resultReporter) ReportTestResult(propName string, result *gopter.TestResult) {
+	r[propName] = result
+}
+
+// checkLaws runs the properties and checks that exactly the expected ones fail
+func checkLaws(t *testing.T, properties *gopter.Properties, failing ...string) resultReporter {
+	reporter := resultReporter{}
+	properties.Run(reporter)
+	for _, name := range failing {
+		if result, ok := reporter[name]; !ok || result.Status != gopter.TestFailed {
+			t.Errorf("Property %s has not failed: %#v", name, result)
+		}
+	}
+	for name, result := range reporter {
+		expected := false
+		for _, f := range failing {
+			expected = expected || f == name
+		}
+		if !expected && !result.Passed() {
+			t.Errorf("Property %s has failed: %#v", name, result)
+		}
+	}
+	return reporter
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func TestMonoid(t *testing.T) {
+	properties := gopter.NewProperties(nil)
+	laws.CommutativeMonoid(properties, "sum", gen.Int(), func(a, b int) int {
+		return a + b
+	}, 0)
+	laws.Monoid(properties, "concat", gen.AlphaString(), func(a, b string) string {
+		return a + b
+	}, "")
+	checkLaws(t, properties)
+
+	properties = gopter.NewProperties(nil)
+	laws.CommutativeMonoid(properties, "minus", gen.IntRange(-100, 100), func(a, b int) int {
+		return a - b
+	}, 0)
+	reporter := checkLaws(t, properties, "minus: associativity", "minus: commutativity", "minus: left identity")
+	if labels := reporter["minus: left identity"].Labels; len(labels) != 1 ||
+		(labels[0] != "op(identity, a) = -1 != a = 1" && labels[0] != "op(identity, a) = 1 != a = -1") {
+		t.Errorf("Invalid labels: %#v", labels)
+	}
+
+	properties = gopter.NewProperties(nil)
+	laws.Monoid(properties, "average", gen.IntRange(-100, 100), func(a, b int) int {
+		return (a + b) / 2
+	}, 0)
+	checkLaws(t, properties, "average: associativity", "average: left identity", "average: right identity")
+}
+
+func TestSemilattice(t *testing.T) {
+	properties := gopter.NewProperties(nil)
+	laws.Semilattice(properties, "max", gen.Int(), maxInt)
+	// time.Time is compared with its Equal method, i.e. the location does not matter
+	laws.Semilattice(properties, "latest", gen.Time(), func(a, b time.Time) time.Time {
+		if a.After(b) {
+			return a.UTC()
+		}
+		return b
+	})
+	checkLaws(t, properties)
+
+	properties = gopter.NewProperties(nil)
+	laws.Semilattice(properties, "sum", gen.IntRange(1, 100), func(a, b int) int {
+		return a + b
+	})
+	checkLaws(t, properties, "sum: idempotence")
+}
+
+func TestLattice(t *testing.T) {
+	properties := gopter.NewProperties(nil)
+	laws.Lattice(properties, "max/min", gen.Int(), maxInt, minInt)
+	checkLaws(t, properties)
+
+	properties = gopter.NewProperties(nil)
+	laws.Absorption(properties, "max/plus", gen.IntRange(-100, 100), maxInt, func(a, b int) int {
+		return a + b
+	})
+	checkLaws(t, properties, "max/plus: absorption of meet", "max/plus: absorption of join")
+}
+
+func TestInvalidOperation(t *testing.T) {
+	for _, op := range []interface{}{
+		42,
+		func(a int) int { return a },
+		func(a int, b string) int { return a },
+		func(a, b int) string { return "" },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic for %T", op)
+				}
+			}()
+			laws.Associative(gopter.NewProperties(nil), "invalid", gen.Int(), op)
+		}()
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for invalid identity")
+		}
+	}()
+	laws.Identity(gopter.NewProperties(nil), "invalid", gen.Int(), maxInt, "0")
+}
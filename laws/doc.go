@@ -0,0 +1,23 @@
+/*
+Package laws contains suites of properties checking algebraic laws of operations, e.g. that a
+merge operation is associative, commutative and idempotent (i.e. a semilattice) or that a
+comparison is a total order.
+
+Every suite registers its properties on a gopter.Properties, the names of the properties are
+prefixed by the given name. The operations are functions of the generated type, e.g.
+
+	func TestMergeConfig(t *testing.T) {
+		properties := gopter.NewProperties(nil)
+
+		laws.Monoid(properties, "Config.Merge", genConfig(), func(a, b Config) Config {
+			return a.Merge(b)
+		}, Config{})
+
+		properties.TestingRun(t)
+	}
+
+Results of the operations are compared with their Equal method if the type has one (i.e. a method
+Equal(T) bool), with reflect.DeepEqual otherwise.
+If a law fails the generated values are shrinked and the compared results are reported as labels.
+*/
+package laws
@@ -0,0 +1,69 @@
+package laws
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var typeOfBool = reflect.TypeOf(true)
+
+// operation is a binary operation func(T, T) T (or relation func(T, T) bool) called via reflection
+type operation struct {
+	fn     reflect.Value
+	inType reflect.Type
+}
+
+// newOperation checks the signature of fn, out is the expected return type (nil for the
+// parameter type)
+func newOperation(kind string, fn interface{}, out reflect.Type) operation {
+	fnVal := reflect.ValueOf(fn)
+	if fnVal.Kind() != reflect.Func {
+		panic(fmt.Sprintf("%s has to be a func, but is %v", kind, fnVal.Kind()))
+	}
+	fnType := fnVal.Type()
+	if fnType.NumIn() != 2 || fnType.In(0) != fnType.In(1) {
+		panic(fmt.Sprintf("%s has to be a func with two params of the same type, but is %v", kind, fnType))
+	}
+	if out == nil {
+		out = fnType.In(0)
+	}
+	if fnType.NumOut() != 1 || fnType.Out(0) != out {
+		panic(fmt.Sprintf("%s has to be a func returning %v, but is %v", kind, out, fnType))
+	}
+	return operation{fn: fnVal, inType: fnType.In(0)}
+}
+
+func (o operation) value(v interface{}) reflect.Value {
+	if v == nil {
+		return reflect.Zero(o.inType)
+	}
+	return reflect.ValueOf(v)
+}
+
+func (o operation) apply(a, b interface{}) interface{} {
+	return o.fn.Call([]reflect.Value{o.value(a), o.value(b)})[0].Interface()
+}
+
+func (o operation) holds(a, b interface{}) bool {
+	return o.apply(a, b).(bool)
+}
+
+// equal compares with an Equal method if a has one, with reflect.DeepEqual otherwise
+func equal(a, b interface{}) bool {
+	if a != nil && b != nil {
+		method := reflect.ValueOf(a).MethodByName("Equal")
+		if method.IsValid() && method.Type().NumIn() == 1 && method.Type().NumOut() == 1 &&
+			method.Type().Out(0) == typeOfBool && reflect.TypeOf(b).AssignableTo(method.Type().In(0)) {
+			return method.Call([]reflect.Value{reflect.ValueOf(b)})[0].Bool()
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// compare creates the label of a failed law if the results differ
+func compare(leftExpr string, left interface{}, rightExpr string, right interface{}) string {
+	if equal(left, right) {
+		return ""
+	}
+	return fmt.Sprintf("%s = %#v != %s = %#v", leftExpr, left, rightExpr, right)
+}
@@ -0,0 +1,45 @@
+package laws
+
+import (
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// PartialOrder registers the laws of a partial order, i.e. lessEq has to be reflexive,
+// antisymmetric and transitive.
+// lessEq: has to be a func(T, T) bool for the type T of the generated values.
+func PartialOrder(properties *gopter.Properties, name string, gen gopter.Gen, lessEq interface{}) {
+	le := newOperation("Relation", lessEq, typeOfBool)
+	properties.Property(name+": reflexivity", prop.ForAll(func(a interface{}) string {
+		if !le.holds(a, a) {
+			return "not lessEq(a, a)"
+		}
+		return ""
+	}, gen))
+	properties.Property(name+": antisymmetry", prop.ForAll(func(a, b interface{}) string {
+		if le.holds(a, b) && le.holds(b, a) && !equal(a, b) {
+			return "lessEq(a, b) and lessEq(b, a), but a != b"
+		}
+		return ""
+	}, gen, gen))
+	properties.Property(name+": transitivity", prop.ForAll(func(a, b, c interface{}) string {
+		if le.holds(a, b) && le.holds(b, c) && !le.holds(a, c) {
+			return "lessEq(a, b) and lessEq(b, c), but not lessEq(a, c)"
+		}
+		return ""
+	}, gen, gen, gen))
+}
+
+// TotalOrder registers the laws of a total order, i.e. lessEq has to be a partial order (see
+// PartialOrder) and all values have to be comparable.
+// lessEq: has to be a func(T, T) bool for the type T of the generated values.
+func TotalOrder(properties *gopter.Properties, name string, gen gopter.Gen, lessEq interface{}) {
+	PartialOrder(properties, name, gen, lessEq)
+	le := newOperation("Relation", lessEq, typeOfBool)
+	properties.Property(name+": totality", prop.ForAll(func(a, b interface{}) string {
+		if !le.holds(a, b) && !le.holds(b, a) {
+			return "neither lessEq(a, b) nor lessEq(b, a)"
+		}
+		return ""
+	}, gen, gen))
+}
@@ -0,0 +1,49 @@
+package laws_test
+
+import (
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/laws"
+)
+
+func TestTotalOrder(t *testing.T) {
+	properties := gopter.NewProperties(nil)
+	laws.TotalOrder(properties, "<=", gen.IntRange(-10, 10), func(a, b int) bool {
+		return a <= b
+	})
+	checkLaws(t, properties)
+
+	properties = gopter.NewProperties(nil)
+	laws.TotalOrder(properties, "<", gen.IntRange(-10, 10), func(a, b int) bool {
+		return a < b
+	})
+	checkLaws(t, properties, "<: reflexivity", "<: totality")
+
+	properties = gopter.NewProperties(nil)
+	laws.TotalOrder(properties, "abs", gen.IntRange(-1, 1), func(a, b int) bool {
+		return a*a <= b*b
+	})
+	checkLaws(t, properties, "abs: antisymmetry")
+}
+
+func TestPartialOrder(t *testing.T) {
+	subset := func(a, b uint8) bool {
+		return a&b == a
+	}
+
+	properties := gopter.NewProperties(nil)
+	laws.PartialOrder(properties, "subset", gen.UInt8(), subset)
+	checkLaws(t, properties)
+
+	properties = gopter.NewProperties(nil)
+	laws.TotalOrder(properties, "subset", gen.UInt8(), subset)
+	checkLaws(t, properties, "subset: totality")
+
+	properties = gopter.NewProperties(nil)
+	laws.PartialOrder(properties, "distance", gen.IntRange(0, 3), func(a, b int) bool {
+		return b-a <= 1 && a-b <= 1
+	})
+	checkLaws(t, properties, "distance: antisymmetry", "distance: transitivity")
+}
@@ -31,16 +31,20 @@ func (prop Prop) Check(parameters *TestParameters) *TestResult {
 	sizeStep := float64(parameters.MaxSize-parameters.MinSize) / (iterations * float64(parameters.Workers))
 
 	genParameters := GenParameters{
-		MinSize:        parameters.MinSize,
-		MaxSize:        parameters.MaxSize,
-		MaxShrinkCount: parameters.MaxShrinkCount,
-		Rng:            parameters.Rng,
+		MinSize:             parameters.MinSize,
+		MaxSize:             parameters.MaxSize,
+		MaxShrinkCount:      parameters.MaxShrinkCount,
+		EdgeCaseProbability: parameters.EdgeCaseProbability,
+		Rng:                 parameters.Rng,
+		values:              parameters.values,
 	}
 	runner := &runner{
 		parameters: parameters,
-		worker: func(workerIdx int, shouldStop shouldStop) *TestResult {
+		worker: func(workerIdx int, subSeed int64, shouldStop shouldStop) (*TestResult, int) {
 			var n int
 			var d int
+			// every worker gets its own lock-free stream of random numbers
+			workerParameters := genParameters.WithSource(NewSplitMixSource(subSeed))
 
 			isExhaused := func() bool {
 				return n+d > parameters.MinSuccessfulTests &&
@@ -48,8 +52,9 @@ func (prop Prop) Check(parameters *TestParameters) *TestResult {
 			}
 
 			for !shouldStop() && n < int(iterations) {
-				size := float64(parameters.MinSize) + (sizeStep * float64(workerIdx+(parameters.Workers*(n+d))))
-				propResult := prop(genParameters.WithSize(int(size)))
+				caseIdx := workerIdx + parameters.Workers*(n+d)
+				size := float64(parameters.MinSize) + (sizeStep * float64(caseIdx))
+				propResult := prop(workerParameters.WithSize(int(size)))
 
 				switch propResult.Status {
 				case PropUndecided:
@@ -59,7 +64,7 @@ func (prop Prop) Check(parameters *TestParameters) *TestResult {
 							Status:    TestExhausted,
 							Succeeded: n,
 							Discarded: d,
-						}
+						}, 0
 					}
 				case PropTrue:
 					n++
@@ -71,7 +76,8 @@ func (prop Prop) Check(parameters *TestParameters) *TestResult {
 						Discarded: d,
 						Labels:    propResult.Labels,
 						Args:      propResult.Args,
-					}
+						Trace:     propResult.Trace,
+					}, caseIdx
 				case PropFalse:
 					return &TestResult{
 						Status:    TestFailed,
@@ -79,7 +85,8 @@ func (prop Prop) Check(parameters *TestParameters) *TestResult {
 						Discarded: d,
 						Labels:    propResult.Labels,
 						Args:      propResult.Args,
-					}
+						Trace:     propResult.Trace,
+					}, caseIdx
 				case PropError:
 					return &TestResult{
 						Status:    TestError,
@@ -88,7 +95,8 @@ func (prop Prop) Check(parameters *TestParameters) *TestResult {
 						Labels:    propResult.Labels,
 						Error:     propResult.Error,
 						Args:      propResult.Args,
-					}
+						Trace:     propResult.Trace,
+					}, caseIdx
 				}
 			}
 
@@ -97,13 +105,13 @@ func (prop Prop) Check(parameters *TestParameters) *TestResult {
 					Status:    TestExhausted,
 					Succeeded: n,
 					Discarded: d,
-				}
+				}, 0
 			}
 			return &TestResult{
 				Status:    TestPassed,
 				Succeeded: n,
 				Discarded: d,
-			}
+			}, 0
 		},
 	}
 
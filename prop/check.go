@@ -0,0 +1,23 @@
+package prop
+
+import "github.com/leanovate/gopter"
+
+/*
+Check creates a property from an imperative check function, that draws its values from
+generators while checking the property, e.g.
+
+	prop.Check(func(t *gopter.T) {
+		n := t.Draw(gen.IntRange(1, 10), "n").(int)
+		xs := t.Draw(gen.SliceOfN(n, gen.Int()), "xs").([]int)
+		if len(xs) != n {
+			t.Errorf("invalid length: %d", len(xs))
+		}
+	})
+
+The property is falsified if the check fails (e.g. t.Errorf, t.Fatalf or an assertion library
+using testing.TB), all draws are reported with their labels. Falsified properties are shrinked
+by replaying the draws with shrinked values (see gopter.DrawProp).
+*/
+func Check(check func(*gopter.T)) gopter.Prop {
+	return gopter.DrawProp(check)
+}
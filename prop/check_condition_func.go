@@ -8,7 +8,7 @@ import (
 	"github.com/leanovate/gopter"
 )
 
-func checkConditionFunc(check interface{}, numArgs int) (func([]reflect.Value) *gopter.PropResult, error) {
+func checkConditionFunc(check interface{}, numArgs int) (func(*gopter.GenParameters, []reflect.Value) *gopter.PropResult, error) {
 	checkVal := reflect.ValueOf(check)
 	checkType := checkVal.Type()
 
@@ -25,16 +25,16 @@ func checkConditionFunc(check interface{}, numArgs int) (func([]reflect.Value) *
 	} else if checkType.NumOut() == 2 && !checkType.Out(1).Implements(typeOfError) {
 		return nil, fmt.Errorf("No 2 output has to be error: %v", checkType.Out(1).Kind())
 	} else if checkType.NumOut() == 2 {
-		return func(values []reflect.Value) *gopter.PropResult {
+		return func(genParams *gopter.GenParameters, values []reflect.Value) *gopter.PropResult {
 			results := checkVal.Call(values)
 			if results[1].IsNil() {
-				return convertResult(results[0].Interface(), nil)
+				return evalResult(genParams, results[0].Interface(), nil)
 			}
-			return convertResult(results[0].Interface(), results[1].Interface().(error))
+			return evalResult(genParams, results[0].Interface(), results[1].Interface().(error))
 		}, nil
 	}
-	return func(values []reflect.Value) *gopter.PropResult {
+	return func(genParams *gopter.GenParameters, values []reflect.Value) *gopter.PropResult {
 		results := checkVal.Call(values)
-		return convertResult(results[0].Interface(), nil)
+		return evalResult(genParams, results[0].Interface(), nil)
 	}, nil
 }
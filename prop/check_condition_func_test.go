@@ -3,6 +3,8 @@ package prop
 import (
 	"reflect"
 	"testing"
+
+	"github.com/leanovate/gopter"
 )
 
 func TestCheckCondition(t *testing.T) {
@@ -47,7 +49,7 @@ func TestCheckCondition(t *testing.T) {
 	if err != nil || call == nil {
 		t.Error("Should work")
 	}
-	result := call([]reflect.Value{
+	result := call(gopter.DefaultGenParameters(), []reflect.Value{
 		reflect.ValueOf(123),
 		reflect.ValueOf(456),
 	})
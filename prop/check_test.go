@@ -0,0 +1,37 @@
+package prop_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestCheck(t *testing.T) {
+	properties := gopter.NewProperties(nil)
+
+	properties.Property("sorted slice contains drawn element", prop.Check(func(t *gopter.T) {
+		xs := t.Draw(gen.SliceOf(gen.Int()).SuchThat(func(xs []int) bool {
+			return len(xs) > 0
+		}), "xs").([]int)
+		x := t.Draw(gen.OneConstOf(xs[0], xs[len(xs)-1]), "x").(int)
+		sorted := append([]int{}, xs...)
+		sort.Ints(sorted)
+		if idx := sort.SearchInts(sorted, x); idx >= len(sorted) || sorted[idx] != x {
+			t.Errorf("%d not found in %v", x, sorted)
+		}
+	}))
+
+	properties.TestingRun(t)
+
+	result := prop.Check(func(t *gopter.T) {
+		if x := t.Draw(gen.IntRange(0, 1000), "x").(int); x >= 10 {
+			t.Fatalf("x is %d", x)
+		}
+	}).Check(gopter.DefaultTestParameters())
+	if result.Status != gopter.TestFailed || len(result.Args) != 1 || result.Args[0].Arg != 10 {
+		t.Errorf("Invalid result: %#v", result)
+	}
+}
@@ -0,0 +1,86 @@
+package prop
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/leanovate/gopter"
+)
+
+// And creates a property that is only true if both properties are true.
+// Labels and arguments of both properties are merged.
+func And(prop1, prop2 gopter.Prop) gopter.Prop {
+	return func(genParams *gopter.GenParameters) *gopter.PropResult {
+		return gopter.SaveProp(prop1)(genParams).And(gopter.SaveProp(prop2)(genParams))
+	}
+}
+
+// Or creates a property that is true if one of the properties is true.
+// Labels and arguments of both properties are merged.
+func Or(prop1, prop2 gopter.Prop) gopter.Prop {
+	return func(genParams *gopter.GenParameters) *gopter.PropResult {
+		return gopter.SaveProp(prop1)(genParams).Or(gopter.SaveProp(prop2)(genParams))
+	}
+}
+
+// All creates a property that is only true if all properties are true (i.e. an And of
+// all properties). All without properties is always true.
+func All(props ...gopter.Prop) gopter.Prop {
+	return func(genParams *gopter.GenParameters) *gopter.PropResult {
+		result := &gopter.PropResult{Status: gopter.PropTrue}
+		for _, prop := range props {
+			result = result.And(gopter.SaveProp(prop)(genParams))
+		}
+		return result
+	}
+}
+
+// Implies creates a property that is only checked if condition is true. Otherwise the
+// property is undecided, i.e. the test case is discarded.
+// This is mostly useful inside the condition of ForAll, e.g.
+//
+//	prop.ForAll(func(n int) gopter.Prop {
+//		return prop.Implies(n != 0, prop.Label("inverse", ...))
+//	}, gen.Int())
+func Implies(condition bool, prop gopter.Prop) gopter.Prop {
+	return func(genParams *gopter.GenParameters) *gopter.PropResult {
+		if !condition {
+			return &gopter.PropResult{Status: gopter.PropUndecided}
+		}
+		return gopter.SaveProp(prop)(genParams)
+	}
+}
+
+// Label creates a property with an additional label, that is reported if the property is falsified.
+func Label(label string, prop gopter.Prop) gopter.Prop {
+	return func(genParams *gopter.GenParameters) *gopter.PropResult {
+		result := gopter.SaveProp(prop)(genParams)
+		labels := make([]string, 0, len(result.Labels)+1)
+		result.Labels = append(append(labels, label), result.Labels...)
+		return result
+	}
+}
+
+// Within creates a property that is falsified if the check of prop takes longer than duration.
+// Note: The check of prop is not aborted but continues in the background (with its own stream of
+// random numbers).
+func Within(duration time.Duration, prop gopter.Prop) gopter.Prop {
+	return func(genParams *gopter.GenParameters) *gopter.PropResult {
+		done := make(chan *gopter.PropResult, 1)
+		splitParams := genParams.Split()
+		go func() {
+			done <- gopter.SaveProp(prop)(splitParams)
+		}()
+		timer := time.NewTimer(duration)
+		defer timer.Stop()
+		select {
+		case result := <-done:
+			return result
+		case <-timer.C:
+			return &gopter.PropResult{
+				Status: gopter.PropFalse,
+				Labels: []string{fmt.Sprintf("Timeout of %v exceeded", duration)},
+			}
+		}
+	}
+}
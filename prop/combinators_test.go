@@ -0,0 +1,95 @@
+package prop_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func constProp(status gopter.PropResult) gopter.Prop {
+	return func(*gopter.GenParameters) *gopter.PropResult {
+		result := status
+		return &result
+	}
+}
+
+func TestAndOrAll(t *testing.T) {
+	params := gopter.DefaultGenParameters()
+	trueProp := constProp(gopter.PropResult{Status: gopter.PropTrue, Labels: []string{"true"}})
+	falseProp := constProp(gopter.PropResult{Status: gopter.PropFalse, Labels: []string{"false"}})
+
+	if result := prop.And(trueProp, trueProp)(params); result.Status != gopter.PropTrue || len(result.Labels) != 2 {
+		t.Errorf("Invalid and: %#v", result)
+	}
+	if result := prop.And(trueProp, falseProp)(params); result.Status != gopter.PropFalse {
+		t.Errorf("Invalid and: %#v", result)
+	}
+	if result := prop.Or(falseProp, trueProp)(params); result.Status != gopter.PropTrue {
+		t.Errorf("Invalid or: %#v", result)
+	}
+	if result := prop.Or(falseProp, falseProp)(params); result.Status != gopter.PropFalse || len(result.Labels) != 2 {
+		t.Errorf("Invalid or: %#v", result)
+	}
+	if result := prop.All()(params); result.Status != gopter.PropTrue {
+		t.Errorf("Invalid all: %#v", result)
+	}
+	if result := prop.All(trueProp, trueProp, falseProp)(params); result.Status != gopter.PropFalse {
+		t.Errorf("Invalid all: %#v", result)
+	}
+	panicProp := gopter.Prop(func(*gopter.GenParameters) *gopter.PropResult {
+		panic("Ouchy")
+	})
+	if result := prop.All(trueProp, panicProp)(params); result.Status != gopter.PropError {
+		t.Errorf("Invalid all: %#v", result)
+	}
+}
+
+func TestImpliesLabel(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	result := prop.ForAll(func(n int) gopter.Prop {
+		return prop.Implies(n%2 == 0, prop.Label("even", prop.ForAll(func(m int) bool {
+			return (n*m)%2 == 0
+		}, gen.Int())))
+	}, gen.Int()).Check(parameters)
+	if !result.Passed() {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	result = prop.ForAll(func(n int) gopter.Prop {
+		return prop.Implies(n > 0, prop.Label("positive", func(*gopter.GenParameters) *gopter.PropResult {
+			return gopter.NewPropResult(n < 100, "smaller 100")
+		}))
+	}, gen.IntRange(-1000, 1000)).Check(parameters)
+	if result.Status != gopter.TestFailed || len(result.Args) != 1 || result.Args[0].Arg != 100 {
+		t.Errorf("Invalid result: %#v", result)
+	}
+	if len(result.Labels) != 2 || result.Labels[0] != "positive" || result.Labels[1] != "smaller 100" {
+		t.Errorf("Invalid labels: %#v", result.Labels)
+	}
+
+	result = prop.ForAll(func(n int) gopter.Prop {
+		return prop.Implies(false, prop.ErrorProp(nil))
+	}, gen.Int()).Check(parameters)
+	if result.Status != gopter.TestExhausted {
+		t.Errorf("Invalid result: %#v", result)
+	}
+}
+
+func TestWithin(t *testing.T) {
+	params := gopter.DefaultGenParameters()
+	fast := prop.Within(time.Second, constProp(gopter.PropResult{Status: gopter.PropTrue}))
+	if result := fast(params); result.Status != gopter.PropTrue {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	slow := prop.Within(10*time.Millisecond, func(*gopter.GenParameters) *gopter.PropResult {
+		time.Sleep(time.Second)
+		return &gopter.PropResult{Status: gopter.PropTrue}
+	})
+	if result := slow(params); result.Status != gopter.PropFalse || len(result.Labels) != 1 {
+		t.Errorf("Invalid result: %#v", result)
+	}
+}
@@ -35,3 +35,12 @@ func convertResult(result interface{}, err error) *gopter.PropResult {
 		Error:  fmt.Errorf("Invalid check result: %#v", result),
 	}
 }
+
+// evalResult converts the result of a check condition, a gopter.Prop (e.g. created by one of the
+// combinators) is checked with the given parameters
+func evalResult(genParams *gopter.GenParameters, result interface{}, err error) *gopter.PropResult {
+	if prop, ok := result.(gopter.Prop); ok && err == nil {
+		return gopter.SaveProp(prop)(genParams)
+	}
+	return convertResult(result, err)
+}
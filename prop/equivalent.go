@@ -0,0 +1,112 @@
+package prop
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/leanovate/gopter"
+)
+
+var typeOfPropResult = reflect.TypeOf((*gopter.PropResult)(nil))
+
+// outcome of a function call, i.e. either its return values or the recovered panic
+type outcome struct {
+	results  []interface{}
+	panicked bool
+	panic    interface{}
+}
+
+func callRecover(fn reflect.Value, args []reflect.Value) (o outcome) {
+	defer func() {
+		if r := recover(); r != nil {
+			o = outcome{panicked: true, panic: r}
+		}
+	}()
+	var results []reflect.Value
+	if fn.Type().IsVariadic() {
+		results = fn.CallSlice(args)
+	} else {
+		results = fn.Call(args)
+	}
+	o.results = make([]interface{}, len(results))
+	for i, result := range results {
+		o.results[i] = result.Interface()
+	}
+	return o
+}
+
+/*
+Equivalent creates a property that requires the functions f and g to have the same outcome
+for all values, i.e. a property for differential testing of an implementation against a
+reference implementation. The outputs are compared with reflect.DeepEqual (see EquivalentWith).
+
+"f" and "g" have to be functions of the same type with the same number of parameters as the
+provided generators "gens". All return values (including errors) are compared, if one of the
+functions panics the other has to panic with an equal value as well. If the outcomes differ the
+generated values are shrinked and the differing outputs are reported as labels.
+*/
+func Equivalent(f, g interface{}, gens ...gopter.Gen) gopter.Prop {
+	return EquivalentWith(reflect.DeepEqual, f, g, gens...)
+}
+
+// EquivalentWith creates a property like Equivalent, the return values (and panics) of f and g
+// are compared with equal.
+func EquivalentWith(equal func(a, b interface{}) bool, f, g interface{}, gens ...gopter.Gen) gopter.Prop {
+	fVal := reflect.ValueOf(f)
+	gVal := reflect.ValueOf(g)
+
+	if fVal.Kind() != reflect.Func || gVal.Kind() != reflect.Func {
+		return ErrorProp(fmt.Errorf("Params of Equivalent have to be funcs: %v, %v", fVal.Kind(), gVal.Kind()))
+	}
+	fType := fVal.Type()
+	if fType != gVal.Type() {
+		return ErrorProp(fmt.Errorf("Params of Equivalent have to be of the same type: %v != %v", fType, gVal.Type()))
+	}
+	if fType.NumIn() != len(gens) {
+		return ErrorProp(fmt.Errorf("Number of parameters does not match number of generators: %d != %d", fType.NumIn(), len(gens)))
+	}
+
+	in := make([]reflect.Type, fType.NumIn())
+	for i := range in {
+		in[i] = fType.In(i)
+	}
+	conditionType := reflect.FuncOf(in, []reflect.Type{typeOfPropResult}, false)
+	condition := reflect.MakeFunc(conditionType, func(args []reflect.Value) []reflect.Value {
+		result := &gopter.PropResult{Status: gopter.PropTrue}
+		if labels := outcomeDiff(equal, callRecover(fVal, args), callRecover(gVal, args)); len(labels) > 0 {
+			result = &gopter.PropResult{Status: gopter.PropFalse, Labels: labels}
+		}
+		return []reflect.Value{reflect.ValueOf(result)}
+	})
+
+	return ForAll(condition.Interface(), gens...)
+}
+
+// outcomeDiff creates a label for every difference of the outcomes
+func outcomeDiff(equal func(a, b interface{}) bool, fOutcome, gOutcome outcome) []string {
+	switch {
+	case fOutcome.panicked && gOutcome.panicked:
+		if equal(fOutcome.panic, gOutcome.panic) {
+			return nil
+		}
+		return []string{fmt.Sprintf("panic: %#v != %#v", fOutcome.panic, gOutcome.panic)}
+	case fOutcome.panicked:
+		return []string{fmt.Sprintf("panic: %#v != no panic", fOutcome.panic)}
+	case gOutcome.panicked:
+		return []string{fmt.Sprintf("panic: no panic != %#v", gOutcome.panic)}
+	}
+	var labels []string
+	for i, fResult := range fOutcome.results {
+		if gResult := gOutcome.results[i]; !equal(fResult, gResult) {
+			labels = append(labels, fmt.Sprintf("result %d: %s != %s", i, formatResult(fResult), formatResult(gResult)))
+		}
+	}
+	return labels
+}
+
+func formatResult(result interface{}) string {
+	if err, ok := result.(error); ok {
+		return fmt.Sprintf("error(%q)", err.Error())
+	}
+	return fmt.Sprintf("%#v", result)
+}
@@ -0,0 +1,105 @@
+package prop_test
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func naiveSort(xs []int) []int {
+	sorted := append([]int{}, xs...)
+	for i := range sorted {
+		for j := i + 1; j < len(sorted); j++ {
+			if sorted[j] < sorted[i] {
+				sorted[i], sorted[j] = sorted[j], sorted[i]
+			}
+		}
+	}
+	return sorted
+}
+
+func TestEquivalent(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	result := prop.Equivalent(naiveSort, func(xs []int) []int {
+		sorted := append([]int{}, xs...)
+		sort.Ints(sorted)
+		return sorted
+	}, gen.SliceOf(gen.Int())).Check(parameters)
+	if !result.Passed() {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	result = prop.Equivalent(naiveSort, func(xs []int) []int {
+		sorted := append([]int{}, xs...)
+		sort.Ints(sorted)
+		if len(sorted) > 2 {
+			return sorted[:2]
+		}
+		return sorted
+	}, gen.SliceOf(gen.Int())).Check(parameters)
+	if result.Status != gopter.TestFailed || len(result.Args) != 1 || len(result.Args[0].Arg.([]int)) != 3 {
+		t.Errorf("Invalid result: %#v", result)
+	}
+	if len(result.Labels) != 1 || !strings.HasPrefix(result.Labels[0], "result 0: []int{") {
+		t.Errorf("Invalid labels: %#v", result.Labels)
+	}
+}
+
+func TestEquivalentErrorsAndPanics(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	atoi := func(str string) (int, error) {
+		if str == "" {
+			return 0, errors.New("empty")
+		}
+		return strconv.Atoi(str)
+	}
+	result := prop.Equivalent(atoi, func(str string) (int, error) {
+		if str == "" {
+			return 0, errors.New("empty")
+		}
+		n, err := strconv.ParseInt(str, 10, 0)
+		return int(n), err
+	}, gen.NumString()).Check(parameters)
+	if result.Status != gopter.TestFailed || len(result.Labels) != 1 || !strings.HasPrefix(result.Labels[0], "result 1: error(\"strconv.Atoi: ") {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	index := func(xs []int, i uint8) int {
+		return xs[i]
+	}
+	result = prop.Equivalent(index, func(xs []int, i uint8) int {
+		if int(i) >= len(xs) {
+			return 0
+		}
+		return xs[i]
+	}, gen.SliceOf(gen.Int()), gen.UInt8()).Check(parameters)
+	if result.Status != gopter.TestFailed || len(result.Labels) != 1 || !strings.HasPrefix(result.Labels[0], "panic: ") {
+		t.Errorf("Invalid result: %#v", result)
+	}
+}
+
+func TestEquivalentWith(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	sameSign := func(a, b interface{}) bool {
+		return (a.(int) < 0) == (b.(int) < 0)
+	}
+	result := prop.EquivalentWith(sameSign, func(n int) int {
+		return n
+	}, func(n int) int {
+		return n * 2
+	}, gen.IntRange(-1000, 1000)).Check(parameters)
+	if !result.Passed() {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	result = prop.Equivalent(func(n int) int { return n }, func(n int64) int64 { return n }, gen.Int()).Check(parameters)
+	if result.Status != gopter.TestError {
+		t.Errorf("Invalid result: %#v", result)
+	}
+}
@@ -35,8 +35,8 @@ func Example_invalidconcat() {
 	properties.Run(gopter.ConsoleReporter(false))
 	// Output:
 	// ! length is sum of lengths: Falsified after 17 passed tests.
-	// ARG_0: bahbxh6
-	// ARG_0_ORIGINAL (2 shrinks): pkpbahbxh6
-	// ARG_1: l
-	// ARG_1_ORIGINAL (1 shrinks): dl
+	// ARG_0: mdmmtx2
+	// ARG_0_ORIGINAL (1 shrinks): mdmmtxKaW8nK2
+	// ARG_1: o
+	// ARG_1_ORIGINAL (4 shrinks): rEcbo545swckt
 }
@@ -56,11 +56,11 @@ func Example_quadratic() {
 	properties.Run(gopter.ConsoleReporter(false))
 	// Output:
 	// ! solve quadratic: Falsified after 0 passed tests.
-	// ARG_0: -1.4667384313385178e-05
-	// ARG_0_ORIGINAL (187 shrinks): -1.0960555181801604e+51
-	// ARG_1: 0
-	// ARG_1_ORIGINAL (1 shrinks): -1.1203884793568249e+96
-	// ARG_2: 6.481285637227244e+10
-	// ARG_2_ORIGINAL (905 shrinks): 1.512647219322138e+281
+	// ARG_0: 5e-324
+	// ARG_0_ORIGINAL (680 shrinks): -6.50620859860101e-155
+	// ARG_1: 1
+	// ARG_1_ORIGINAL (553 shrinks): -6.499556212956196e+137
+	// ARG_2: 0
+	// ARG_2_ORIGINAL (1 shrinks): 2.0944946576526083e-237
 	// + solve quadratic with resonable ranges: OK, passed 100 tests.
 }
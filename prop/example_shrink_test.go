@@ -30,7 +30,7 @@ func Example_shrink() {
 	// Output:
 	// ! fail above 100: Falsified after 0 passed tests.
 	// ARG_0: 101
-	// ARG_0_ORIGINAL (56 shrinks): 2041104533947223744
-	// ! fail above 100 no shrink: Falsified after 0 passed tests.
-	// ARG_0: 6006156956070140861
+	// ARG_0_ORIGINAL (58 shrinks): 7544609646990164898
+	// ! fail above 100 no shrink: Falsified after 3 passed tests.
+	// ARG_0: 9223372036854775807
 }
@@ -50,6 +50,6 @@ func Example_timeGen() {
 	//    "2006-01-02T15:04:05.999999999Z07:00": cannot parse "0-01-01T00:00:00Z"
 	//    as "-"
 	// ARG_0: 10000-01-01 00:00:00 +0000 UTC
-	// ARG_0_ORIGINAL (45 shrinks): 237903042092-02-10 19:15:18.148265469 +0000
+	// ARG_0_ORIGINAL (58 shrinks): -199954470998-03-15 10:12:12.271514503 +0000
 	//    UTC
 }
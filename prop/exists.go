@@ -0,0 +1,25 @@
+package prop
+
+import "github.com/leanovate/gopter"
+
+/*
+Exists creates an existential property, i.e. a property that is proved as soon as the check
+condition is true for one of the generated values. If the condition is false the test case is
+discarded, so the property check gives up if no such value is found.
+
+"condition" has to be a function with the same number of parameters as the provided
+generators "gens" (see ForAll).
+*/
+func Exists(condition interface{}, gens ...gopter.Gen) gopter.Prop {
+	forAll := ForAllNoShrink(condition, gens...)
+	return func(genParams *gopter.GenParameters) *gopter.PropResult {
+		result := forAll(genParams)
+		switch result.Status {
+		case gopter.PropTrue:
+			result.Status = gopter.PropProof
+		case gopter.PropFalse:
+			result.Status = gopter.PropUndecided
+		}
+		return result
+	}
+}
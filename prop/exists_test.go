@@ -0,0 +1,26 @@
+package prop_test
+
+import (
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestExists(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	result := prop.Exists(func(n int) bool {
+		return n > 50
+	}, gen.IntRange(0, 100)).Check(parameters)
+	if result.Status != gopter.TestProved || len(result.Args) != 1 || result.Args[0].Arg.(int) <= 50 {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	result = prop.Exists(func(n int) bool {
+		return n > 100
+	}, gen.IntRange(0, 100)).Check(parameters)
+	if result.Status != gopter.TestExhausted {
+		t.Errorf("Invalid result: %#v", result)
+	}
+}
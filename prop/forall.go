@@ -15,7 +15,8 @@ condition falsiies the generated values will be shrinked.
 "condition" has to be a function with the same number of parameters as the provided
 generators "gens". The function may return a simple bool (true means that the
 condition has passed), a string (empty string means that condition has passed),
-a *PropResult, or one of former combined with an error.
+a *PropResult, a gopter.Prop (e.g. created by one of the combinators like Implies), or one of
+former combined with an error.
 */
 func ForAll(condition interface{}, gens ...gopter.Gen) gopter.Prop {
 	callCheck, err := checkConditionFunc(condition, len(gens))
@@ -37,8 +38,8 @@ func ForAll(condition interface{}, gens ...gopter.Gen) gopter.Prop {
 				}
 			}
 		}
-		result := callCheck(values)
-		if result.Success() {
+		result := callCheck(genParams, values)
+		if !falsified(result) {
 			for i, genResult := range genResults {
 				result = result.AddArgs(gopter.NewPropArg(genResult, 0, values[i].Interface(), values[i].Interface()))
 			}
@@ -53,7 +54,7 @@ func ForAll(condition interface{}, gens ...gopter.Gen) gopter.Prop {
 						} else {
 							shrinkedOne[i] = reflect.ValueOf(v)
 						}
-						return callCheck(shrinkedOne)
+						return callCheck(genParams, shrinkedOne)
 					})
 				result = nextResult
 				if nextValue == nil {
@@ -69,10 +70,11 @@ func ForAll(condition interface{}, gens ...gopter.Gen) gopter.Prop {
 
 // ForAll1 legacy interface to be removed in the future
 func ForAll1(gen gopter.Gen, check func(v interface{}) (interface{}, error)) gopter.Prop {
-	checkFunc := func(v interface{}) *gopter.PropResult {
-		return convertResult(check(v))
-	}
 	return gopter.SaveProp(func(genParams *gopter.GenParameters) *gopter.PropResult {
+		checkFunc := func(v interface{}) *gopter.PropResult {
+			result, err := check(v)
+			return evalResult(genParams, result, err)
+		}
 		genResult := gen(genParams)
 		value, ok := genResult.Retrieve()
 		if !ok {
@@ -81,7 +83,7 @@ func ForAll1(gen gopter.Gen, check func(v interface{}) (interface{}, error)) gop
 			}
 		}
 		result := checkFunc(value)
-		if result.Success() {
+		if !falsified(result) {
 			return result.AddArgs(gopter.NewPropArg(genResult, 0, value, value))
 		}
 
@@ -114,10 +116,16 @@ func firstFailure(shrink gopter.Shrink, check func(interface{}) *gopter.PropResu
 	value, ok := shrink()
 	for ok {
 		result := check(value)
-		if !result.Success() {
+		if falsified(result) {
 			return result, value
 		}
 		value, ok = shrink()
 	}
 	return nil, nil
 }
+
+// falsified checks if a result has to be shrinked, i.e. an undecided result (e.g. of Implies)
+// is not considered to be a failure
+func falsified(result *gopter.PropResult) bool {
+	return result.Status == gopter.PropFalse || result.Status == gopter.PropError
+}
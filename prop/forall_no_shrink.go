@@ -13,7 +13,8 @@ As the name suggests the generated values will not be shrinked if the condition
 "condition" has to be a function with the same number of parameters as the provided
 generators "gens". The function may return a simple bool (true means that the
 condition has passed), a string (empty string means that condition has passed),
-a *PropResult, or one of former combined with an error.
+a *PropResult, a gopter.Prop (e.g. created by one of the combinators like Implies), or one of
+former combined with an error.
 */
 func ForAllNoShrink(condition interface{}, gens ...gopter.Gen) gopter.Prop {
 	callCheck, err := checkConditionFunc(condition, len(gens))
@@ -35,7 +36,7 @@ func ForAllNoShrink(condition interface{}, gens ...gopter.Gen) gopter.Prop {
 				}
 			}
 		}
-		result := callCheck(values)
+		result := callCheck(genParams, values)
 		for i, genResult := range genResults {
 			result = result.AddArgs(gopter.NewPropArg(genResult, 0, values[i].Interface(), values[i].Interface()))
 		}
@@ -54,6 +55,7 @@ func ForAllNoShrink1(gen gopter.Gen, check func(interface{}) (interface{}, error
 				Status: gopter.PropUndecided,
 			}
 		}
-		return convertResult(check(value)).AddArgs(gopter.NewPropArg(genResult, 0, value, value))
+		result, err := check(value)
+		return evalResult(genParams, result, err).AddArgs(gopter.NewPropArg(genResult, 0, value, value))
 	})
 }
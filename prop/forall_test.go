@@ -29,7 +29,7 @@ func TestSqrt(t *testing.T) {
 	properties.Property("squared is equal to value", prop.ForAll(
 		func(v float64) bool {
 			r := math.Sqrt(v)
-			return math.Abs(r*r-v) < 1e-10*v
+			return math.Abs(r*r-v) <= 1e-10*v
 		},
 		gen.Float64Range(0, math.MaxFloat64),
 	))
@@ -39,7 +39,7 @@ func TestSqrt(t *testing.T) {
 		func(v interface{}) (interface{}, error) {
 			s := v.(float64)
 			r := math.Sqrt(s)
-			return math.Abs(r*r-s) < 1e-10*s, nil
+			return math.Abs(r*r-s) <= 1e-10*s, nil
 		},
 	))
 
@@ -0,0 +1,157 @@
+package prop
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/leanovate/gopter"
+)
+
+var typeOfInterface = reflect.TypeOf((*interface{})(nil)).Elem()
+
+// Relation is a metamorphic relation, i.e. a transformation of a source input to a follow-up
+// input together with the expected relation of the outputs for both inputs (see Metamorphic).
+type Relation struct {
+	// Name of the relation, reported if the relation is violated
+	Name string
+	// Transform creates the follow-up input. It has to be a func(In) In, or a func(In, P) In if
+	// Param is set.
+	Transform interface{}
+	// Holds checks the outputs of the source and the follow-up input. It has to be a
+	// func(Out, Out) bool, or a func(Out, Out, P) bool if Param is set. If nil the outputs
+	// are required to be equal (see reflect.DeepEqual).
+	Holds interface{}
+	// Param is an optional generator of a parameter of the transformation (e.g. a scaling factor)
+	Param gopter.Gen
+}
+
+// relation is a checked Relation
+type relation struct {
+	name      string
+	transform reflect.Value
+	holds     reflect.Value
+	param     reflect.Type
+}
+
+func newRelation(r Relation, inType, outType reflect.Type) (*relation, error) {
+	transform := reflect.ValueOf(r.Transform)
+	in := []reflect.Type{inType}
+	if r.Param != nil {
+		if transform.Kind() != reflect.Func || transform.Type().NumIn() != 2 {
+			return nil, fmt.Errorf("Transform of relation %s has to be a func(%v, P) %v", r.Name, inType, inType)
+		}
+		in = append(in, transform.Type().In(1))
+	}
+	if transform.Kind() != reflect.Func || transform.Type() != reflect.FuncOf(in, []reflect.Type{inType}, false) {
+		return nil, fmt.Errorf("Transform of relation %s has to be a %v, but is %T", r.Name,
+			reflect.FuncOf(in, []reflect.Type{inType}, false), r.Transform)
+	}
+	result := &relation{name: r.Name, transform: transform}
+	if r.Param != nil {
+		result.param = in[1]
+	}
+
+	if r.Holds != nil {
+		holds := reflect.ValueOf(r.Holds)
+		holdsIn := []reflect.Type{outType, outType}
+		if r.Param != nil {
+			holdsIn = append(holdsIn, result.param)
+		}
+		holdsType := reflect.FuncOf(holdsIn, []reflect.Type{reflect.TypeOf(true)}, false)
+		if holds.Kind() != reflect.Func || holds.Type() != holdsType {
+			return nil, fmt.Errorf("Holds of relation %s has to be a %v, but is %T", r.Name, holdsType, r.Holds)
+		}
+		result.holds = holds
+	}
+	return result, nil
+}
+
+/*
+Metamorphic creates a property for metamorphic testing of f, i.e. testing a function without an
+oracle by checking how its output changes if the input is transformed.
+
+"f" has to be a func(In) Out, the source inputs are generated by "sourceGen". For every relation
+the source input is transformed to a follow-up input and the outputs of f for both inputs have to
+satisfy the relation. E.g. the result of a sum must not depend on the order of the input:
+
+	prop.Metamorphic(sum, gen.SliceOf(gen.Int()), prop.Relation{
+		Name:      "reverse",
+		Transform: func(xs []int) []int { ... },
+	})
+
+If a relation is violated the source input (and parameters of the relation) are shrinked and the
+violated relation is reported as label.
+*/
+func Metamorphic(f interface{}, sourceGen gopter.Gen, relations ...Relation) gopter.Prop {
+	fVal := reflect.ValueOf(f)
+	if fVal.Kind() != reflect.Func || fVal.Type().NumIn() != 1 || fVal.Type().NumOut() != 1 {
+		return ErrorProp(fmt.Errorf("First param of Metamorphic has to be a func with one param and one result, but is %T", f))
+	}
+	inType, outType := fVal.Type().In(0), fVal.Type().Out(0)
+
+	gens := []gopter.Gen{sourceGen}
+	checked := make([]*relation, len(relations))
+	for i, r := range relations {
+		var err error
+		if checked[i], err = newRelation(r, inType, outType); err != nil {
+			return ErrorProp(err)
+		}
+		if r.Param != nil {
+			gens = append(gens, r.Param)
+		}
+	}
+
+	in := make([]reflect.Type, len(gens))
+	for i := range in {
+		in[i] = typeOfInterface
+	}
+	conditionType := reflect.FuncOf(in, []reflect.Type{typeOfPropResult}, false)
+	condition := reflect.MakeFunc(conditionType, func(args []reflect.Value) []reflect.Value {
+		source := valueOf(args[0].Interface(), inType)
+		sourceOutput := fVal.Call([]reflect.Value{source})[0]
+		params := args[1:]
+		for _, r := range checked {
+			transformArgs := []reflect.Value{source}
+			var param reflect.Value
+			if r.param != nil {
+				param, params = valueOf(params[0].Interface(), r.param), params[1:]
+				transformArgs = append(transformArgs, param)
+			}
+			followUp := r.transform.Call(transformArgs)[0]
+			followUpOutput := fVal.Call([]reflect.Value{followUp})[0]
+			if !r.check(sourceOutput, followUpOutput, param) {
+				return []reflect.Value{reflect.ValueOf(&gopter.PropResult{
+					Status: gopter.PropFalse,
+					Labels: []string{
+						fmt.Sprintf("Relation %s violated", r.name),
+						fmt.Sprintf("Source output: %#v", sourceOutput.Interface()),
+						fmt.Sprintf("Follow-up input: %#v", followUp.Interface()),
+						fmt.Sprintf("Follow-up output: %#v", followUpOutput.Interface()),
+					},
+				})}
+			}
+		}
+		return []reflect.Value{reflect.ValueOf(&gopter.PropResult{Status: gopter.PropTrue})}
+	})
+
+	return ForAll(condition.Interface(), gens...)
+}
+
+func (r *relation) check(sourceOutput, followUpOutput, param reflect.Value) bool {
+	if !r.holds.IsValid() {
+		return reflect.DeepEqual(sourceOutput.Interface(), followUpOutput.Interface())
+	}
+	args := []reflect.Value{sourceOutput, followUpOutput}
+	if r.param != nil {
+		args = append(args, param)
+	}
+	return r.holds.Call(args)[0].Bool()
+}
+
+// valueOf converts a generated value to a reflect.Value of type t
+func valueOf(v interface{}, t reflect.Type) reflect.Value {
+	if v == nil {
+		return reflect.Zero(t)
+	}
+	return reflect.ValueOf(v)
+}
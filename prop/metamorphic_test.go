@@ -0,0 +1,99 @@
+package prop_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func sum(xs []int) int {
+	result := 0
+	for _, x := range xs {
+		result += x
+	}
+	return result
+}
+
+func reversed(xs []int) []int {
+	result := make([]int, len(xs))
+	for i, x := range xs {
+		result[len(xs)-1-i] = x
+	}
+	return result
+}
+
+func scaled(xs []int, factor int) []int {
+	result := make([]int, len(xs))
+	for i, x := range xs {
+		result[i] = x * factor
+	}
+	return result
+}
+
+func TestMetamorphic(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	ints := gen.SliceOf(gen.IntRange(-1000, 1000))
+	relations := []prop.Relation{
+		{
+			Name:      "reverse",
+			Transform: reversed,
+		},
+		{
+			Name:      "scale",
+			Transform: scaled,
+			Holds: func(source, followUp, factor int) bool {
+				return followUp == source*factor
+			},
+			Param: gen.IntRange(-10, 10),
+		},
+		{
+			Name: "append",
+			Transform: func(xs []int) []int {
+				return append(append([]int{}, xs...), xs...)
+			},
+			Holds: func(source, followUp int) bool {
+				return followUp == 2*source
+			},
+		},
+	}
+
+	result := prop.Metamorphic(sum, ints, relations...).Check(parameters)
+	if !result.Passed() {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	// the maximum is not scaled by negative factors
+	result = prop.Metamorphic(func(xs []int) int {
+		if len(xs) == 0 {
+			return 0
+		}
+		sorted := append([]int{}, xs...)
+		sort.Ints(sorted)
+		return sorted[len(sorted)-1]
+	}, ints, relations[:2]...).Check(parameters)
+	if result.Status != gopter.TestFailed || len(result.Labels) != 4 || result.Labels[0] != "Relation scale violated" {
+		t.Errorf("Invalid result: %#v", result)
+	}
+	if len(result.Args) != 2 || len(result.Args[0].Arg.([]int)) != 2 || result.Args[1].Arg != -1 {
+		t.Errorf("Invalid args: %#v", result.Args)
+	}
+}
+
+func TestMetamorphicInvalid(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	for _, p := range []gopter.Prop{
+		prop.Metamorphic(42, gen.Int()),
+		prop.Metamorphic(sum, gen.SliceOf(gen.Int()), prop.Relation{Name: "nil"}),
+		prop.Metamorphic(sum, gen.SliceOf(gen.Int()), prop.Relation{Name: "param", Transform: reversed, Param: gen.Int()}),
+		prop.Metamorphic(sum, gen.SliceOf(gen.Int()), prop.Relation{Name: "holds", Transform: reversed, Holds: func(a, b string) bool {
+			return a == b
+		}}),
+	} {
+		if result := p.Check(parameters); result.Status != gopter.TestError {
+			t.Errorf("Invalid result: %#v", result)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package prop
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/leanovate/gopter"
+)
+
+// Panics creates a property that is only true if f panics
+func Panics(f func()) gopter.Prop {
+	return func(genParams *gopter.GenParameters) (result *gopter.PropResult) {
+		defer func() {
+			if r := recover(); r != nil {
+				result = &gopter.PropResult{Status: gopter.PropTrue}
+			}
+		}()
+		f()
+		return &gopter.PropResult{
+			Status: gopter.PropFalse,
+			Labels: []string{"Expected panic"},
+		}
+	}
+}
+
+// Throws creates a property that is only true if f returns an error. If targets are given
+// the error has to match one of them (see errors.Is).
+func Throws(f func() error, targets ...error) gopter.Prop {
+	return func(genParams *gopter.GenParameters) *gopter.PropResult {
+		err := f()
+		if err == nil {
+			return &gopter.PropResult{
+				Status: gopter.PropFalse,
+				Labels: []string{"Expected error"},
+			}
+		}
+		if len(targets) == 0 {
+			return &gopter.PropResult{Status: gopter.PropTrue}
+		}
+		for _, target := range targets {
+			if errors.Is(err, target) {
+				return &gopter.PropResult{Status: gopter.PropTrue}
+			}
+		}
+		return &gopter.PropResult{
+			Status: gopter.PropFalse,
+			Labels: []string{fmt.Sprintf("Unexpected error: %v", err)},
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package prop_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestPanics(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	result := prop.ForAll(func(xs []int) gopter.Prop {
+		return prop.Panics(func() {
+			_ = xs[len(xs)]
+		})
+	}, gen.SliceOf(gen.Int())).Check(parameters)
+	if !result.Passed() {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	result = prop.ForAll(func(xs []int) gopter.Prop {
+		return prop.Panics(func() {
+			_ = xs[:len(xs)]
+		})
+	}, gen.SliceOf(gen.Int())).Check(parameters)
+	if result.Status != gopter.TestFailed || len(result.Labels) != 1 {
+		t.Errorf("Invalid result: %#v", result)
+	}
+}
+
+func TestThrows(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	result := prop.ForAll(func(str string) gopter.Prop {
+		return prop.Throws(func() error {
+			_, err := strconv.Atoi("x" + str)
+			return err
+		}, strconv.ErrSyntax)
+	}, gen.AlphaString()).Check(parameters)
+	if !result.Passed() {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	params := gopter.DefaultGenParameters()
+	if result := prop.Throws(func() error { return nil })(params); result.Status != gopter.PropFalse {
+		t.Errorf("Invalid result: %#v", result)
+	}
+	if result := prop.Throws(func() error { return io.EOF })(params); result.Status != gopter.PropTrue {
+		t.Errorf("Invalid result: %#v", result)
+	}
+	wrapped := func() error { return fmt.Errorf("wrapped: %w", io.EOF) }
+	if result := prop.Throws(wrapped, errors.New("other"), io.EOF)(params); result.Status != gopter.PropTrue {
+		t.Errorf("Invalid result: %#v", result)
+	}
+	if result := prop.Throws(wrapped, io.ErrUnexpectedEOF)(params); result.Status != gopter.PropFalse {
+		t.Errorf("Invalid result: %#v", result)
+	}
+}
@@ -0,0 +1,142 @@
+package prop
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+
+	"github.com/leanovate/gopter"
+)
+
+/*
+RoundTrip creates a property that requires all values of gen to be serialized without loss, i.e.
+decoding an encoded value has to result in an equal value.
+
+"encode" serializes a value, "decode" deserializes the data into a pointer to a new value of the
+same type as the generated one. I.e. the signatures match json.Marshal and json.Unmarshal:
+
+	prop.RoundTrip(gen, json.Marshal, json.Unmarshal, nil)
+
+"equal" compares the original and the decoded value, if nil reflect.DeepEqual is used.
+If the encoding or decoding fails, or the decoded value differs, the property is falsified and
+the generated value is shrinked.
+*/
+func RoundTrip(gen gopter.Gen, encode func(interface{}) ([]byte, error),
+	decode func([]byte, interface{}) error, equal func(a, b interface{}) bool) gopter.Prop {
+	if equal == nil {
+		equal = reflect.DeepEqual
+	}
+	return ForAll(func(value interface{}) *gopter.PropResult {
+		data, err := encode(value)
+		if err != nil {
+			return &gopter.PropResult{
+				Status: gopter.PropFalse,
+				Labels: []string{fmt.Sprintf("Encode failed: %v", err)},
+			}
+		}
+		if value == nil {
+			return &gopter.PropResult{Status: gopter.PropTrue}
+		}
+		target := reflect.New(reflect.TypeOf(value))
+		if err := decode(data, target.Interface()); err != nil {
+			return &gopter.PropResult{
+				Status: gopter.PropFalse,
+				Labels: []string{fmt.Sprintf("Encoded: %q", data), fmt.Sprintf("Decode failed: %v", err)},
+			}
+		}
+		if decoded := target.Elem().Interface(); !equal(value, decoded) {
+			return &gopter.PropResult{
+				Status: gopter.PropFalse,
+				Labels: []string{fmt.Sprintf("Encoded: %q", data), fmt.Sprintf("Decoded: %#v", decoded)},
+			}
+		}
+		return &gopter.PropResult{Status: gopter.PropTrue}
+	}, gen)
+}
+
+// JSONRoundTrip creates a round trip property (see RoundTrip) for encoding/json
+func JSONRoundTrip(gen gopter.Gen) gopter.Prop {
+	return RoundTrip(gen, json.Marshal, json.Unmarshal, nil)
+}
+
+// XMLRoundTrip creates a round trip property (see RoundTrip) for encoding/xml
+func XMLRoundTrip(gen gopter.Gen) gopter.Prop {
+	return RoundTrip(gen, xml.Marshal, xml.Unmarshal, nil)
+}
+
+// GobRoundTrip creates a round trip property (see RoundTrip) for encoding/gob.
+// Note: gob does not distinguish between empty and nil slices or maps, use RoundTrip with
+// a custom equal if the generated values may contain either.
+func GobRoundTrip(gen gopter.Gen) gopter.Prop {
+	return RoundTrip(gen, func(value interface{}) ([]byte, error) {
+		var buf bytes.Buffer
+		err := gob.NewEncoder(&buf).Encode(value)
+		return buf.Bytes(), err
+	}, func(data []byte, target interface{}) error {
+		return gob.NewDecoder(bytes.NewReader(data)).Decode(target)
+	}, nil)
+}
+
+// BinaryRoundTrip creates a round trip property (see RoundTrip) for encoding/binary with
+// the given byte order, i.e. the generated values have to be of a fixed size.
+func BinaryRoundTrip(gen gopter.Gen, order binary.ByteOrder) gopter.Prop {
+	return RoundTrip(gen, func(value interface{}) ([]byte, error) {
+		var buf bytes.Buffer
+		err := binary.Write(&buf, order, value)
+		return buf.Bytes(), err
+	}, func(data []byte, target interface{}) error {
+		return binary.Read(bytes.NewReader(data), order, target)
+	}, nil)
+}
+
+// BinaryMarshalerRoundTrip creates a round trip property (see RoundTrip) for values implementing
+// encoding.BinaryMarshaler and encoding.BinaryUnmarshaler (either by value or by pointer).
+func BinaryMarshalerRoundTrip(gen gopter.Gen) gopter.Prop {
+	return RoundTrip(gen, func(value interface{}) ([]byte, error) {
+		marshaler, ok := withPointerReceiver(value).(encoding.BinaryMarshaler)
+		if !ok {
+			return nil, fmt.Errorf("%T does not implement encoding.BinaryMarshaler", value)
+		}
+		return marshaler.MarshalBinary()
+	}, func(data []byte, target interface{}) error {
+		unmarshaler, ok := target.(encoding.BinaryUnmarshaler)
+		if !ok {
+			return fmt.Errorf("%T does not implement encoding.BinaryUnmarshaler", target)
+		}
+		return unmarshaler.UnmarshalBinary(data)
+	}, nil)
+}
+
+// TextMarshalerRoundTrip creates a round trip property (see RoundTrip) for values implementing
+// encoding.TextMarshaler and encoding.TextUnmarshaler (either by value or by pointer).
+func TextMarshalerRoundTrip(gen gopter.Gen) gopter.Prop {
+	return RoundTrip(gen, func(value interface{}) ([]byte, error) {
+		marshaler, ok := withPointerReceiver(value).(encoding.TextMarshaler)
+		if !ok {
+			return nil, fmt.Errorf("%T does not implement encoding.TextMarshaler", value)
+		}
+		return marshaler.MarshalText()
+	}, func(data []byte, target interface{}) error {
+		unmarshaler, ok := target.(encoding.TextUnmarshaler)
+		if !ok {
+			return fmt.Errorf("%T does not implement encoding.TextUnmarshaler", target)
+		}
+		return unmarshaler.UnmarshalText(data)
+	}, nil)
+}
+
+// withPointerReceiver returns a pointer to a copy of value, so that methods with
+// value and pointer receivers are available
+func withPointerReceiver(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	ptr := reflect.New(reflect.TypeOf(value))
+	ptr.Elem().Set(reflect.ValueOf(value))
+	return ptr.Interface()
+}
@@ -0,0 +1,100 @@
+package prop_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/arbitrary"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+type roundTripStruct struct {
+	Name   string
+	Count  int
+	Ratio  float64
+	Flags  []bool
+	Nested struct {
+		ID uint16
+	}
+}
+
+type fixedSize struct {
+	A int32
+	B uint8
+	C [3]int16
+}
+
+// counter has a lossy binary encoding for values > 255
+type counter int
+
+func (c counter) MarshalBinary() ([]byte, error) {
+	return []byte{byte(c)}, nil
+}
+
+func (c *counter) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 {
+		return errors.New("invalid length")
+	}
+	*c = counter(data[0])
+	return nil
+}
+
+func TestRoundTrip(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	arbitraries := arbitrary.DefaultArbitraries()
+	arbitraries.RegisterGen(gen.AlphaString())
+
+	props := map[string]gopter.Prop{
+		"json":   prop.JSONRoundTrip(arbitraries.GenForType(reflect.TypeOf(roundTripStruct{}))),
+		"xml":    prop.XMLRoundTrip(gen.IntRange(-1000, 1000)),
+		"gob":    prop.GobRoundTrip(gen.SliceOfN(3, gen.Int())),
+		"binary": prop.BinaryRoundTrip(arbitraries.GenForType(reflect.TypeOf(fixedSize{})), binary.LittleEndian),
+		"binary marshaler": prop.BinaryMarshalerRoundTrip(gen.IntRange(0, 255).Map(func(n int) counter {
+			return counter(n)
+		})),
+		"text marshaler": prop.TextMarshalerRoundTrip(gen.SliceOfN(4, gen.UInt8()).Map(func(b []uint8) net.IP {
+			return net.IPv4(b[0], b[1], b[2], b[3])
+		})),
+		"custom": prop.RoundTrip(gen.Float64(), json.Marshal, json.Unmarshal, func(a, b interface{}) bool {
+			return a.(float64) == b.(float64)
+		}),
+	}
+	for name, p := range props {
+		if result := p.Check(parameters); !result.Passed() {
+			t.Errorf("Invalid result of %s: %#v", name, result)
+		}
+	}
+}
+
+func TestRoundTripFalsified(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	result := prop.BinaryMarshalerRoundTrip(gen.IntRange(0, 1000).Map(func(n int) counter {
+		return counter(n)
+	})).Check(parameters)
+	if result.Status != gopter.TestFailed || result.Args[0].Arg != counter(256) {
+		t.Errorf("Invalid result: %#v", result)
+	}
+	if len(result.Labels) != 2 || result.Labels[1] != "Decoded: 0" {
+		t.Errorf("Invalid labels: %#v", result.Labels)
+	}
+
+	result = prop.TextMarshalerRoundTrip(gen.Int()).Check(parameters)
+	if result.Status != gopter.TestFailed || len(result.Labels) != 1 ||
+		!strings.Contains(result.Labels[0], "does not implement encoding.TextMarshaler") {
+		t.Errorf("Invalid result: %#v", result)
+	}
+
+	result = prop.RoundTrip(gen.AlphaString(), json.Marshal, func(data []byte, target interface{}) error {
+		return errors.New("always fail")
+	}, nil).Check(parameters)
+	if result.Status != gopter.TestFailed || len(result.Labels) != 2 || result.Labels[1] != "Decode failed: always fail" {
+		t.Errorf("Invalid result: %#v", result)
+	}
+}
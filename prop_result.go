@@ -37,6 +37,8 @@ type PropResult struct {
 	Error  error
 	Args   []*PropArg
 	Labels []string
+	// Trace contains the steps of the execution of the property (if recorded)
+	Trace []*TraceStep
 }
 
 // NewPropResult create a PropResult with label
@@ -99,10 +101,35 @@ func (r *PropResult) And(other *PropResult) *PropResult {
 	}
 }
 
+// Or combines two PropResult by an or operation.
+// The resulting PropResult will be true if one of the PropResults is true and only false
+// if both PropResults are false.
+func (r *PropResult) Or(other *PropResult) *PropResult {
+	switch {
+	case r.Status == PropError:
+		return r
+	case other.Status == PropError:
+		return other
+	case r.Status == PropProof:
+		return r.mergeWith(other, PropProof)
+	case other.Status == PropProof:
+		return r.mergeWith(other, PropProof)
+	case r.Status == PropTrue || other.Status == PropTrue:
+		return r.mergeWith(other, PropTrue)
+	case r.Status == PropUndecided:
+		return r
+	case other.Status == PropUndecided:
+		return other
+	default:
+		return r.mergeWith(other, PropFalse)
+	}
+}
+
 func (r *PropResult) mergeWith(other *PropResult, status propStatus) *PropResult {
 	return &PropResult{
 		Status: status,
 		Args:   append(append(make([]*PropArg, 0, len(r.Args)+len(other.Args)), r.Args...), other.Args...),
 		Labels: append(append(make([]string, 0, len(r.Labels)+len(other.Labels)), r.Labels...), other.Labels...),
+		Trace:  append(append(make([]*TraceStep, 0, len(r.Trace)+len(other.Trace)), r.Trace...), other.Trace...),
 	}
 }
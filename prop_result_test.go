@@ -64,6 +64,46 @@ func TestPropResult(t *testing.T) {
 	}
 }
 
+func TestPropResultOr(t *testing.T) {
+	statuses := []gopter.PropResult{
+		{Status: gopter.PropProof, Labels: []string{"proof"}},
+		{Status: gopter.PropTrue, Labels: []string{"true"}},
+		{Status: gopter.PropFalse, Labels: []string{"false"}},
+		{Status: gopter.PropUndecided, Labels: []string{"undecided"}},
+		{Status: gopter.PropError, Labels: []string{"error"}},
+	}
+	expected := [][]string{
+		{"PROOF", "PROOF", "PROOF", "PROOF", "ERROR"},
+		{"PROOF", "TRUE", "TRUE", "TRUE", "ERROR"},
+		{"PROOF", "TRUE", "FALSE", "UNDECIDED", "ERROR"},
+		{"PROOF", "TRUE", "UNDECIDED", "UNDECIDED", "ERROR"},
+		{"ERROR", "ERROR", "ERROR", "ERROR", "ERROR"},
+	}
+	for i := range statuses {
+		for j := range statuses {
+			if status := statuses[i].Or(&statuses[j]).Status.String(); status != expected[i][j] {
+				t.Errorf("Invalid combined state of %v or %v: %s", statuses[i].Status, statuses[j].Status, status)
+			}
+		}
+	}
+	if labels := statuses[2].Or(&statuses[2]).Labels; len(labels) != 2 {
+		t.Errorf("Labels not merged: %#v", labels)
+	}
+}
+
+func TestPropResultTrace(t *testing.T) {
+	first := &gopter.PropResult{Status: gopter.PropTrue, Trace: []*gopter.TraceStep{{Name: "first"}}}
+	second := &gopter.PropResult{Status: gopter.PropTrue, Trace: []*gopter.TraceStep{{Name: "second"}}}
+
+	result := first.And(second)
+	if len(result.Trace) != 2 || result.Trace[0].Name != "first" || result.Trace[1].Name != "second" {
+		t.Errorf("Invalid trace: %#v", result.Trace)
+	}
+	if len(first.Trace) != 1 {
+		t.Errorf("Trace of first has been modified: %#v", first.Trace)
+	}
+}
+
 func TestNewPropResult(t *testing.T) {
 	trueResult := gopter.NewPropResult(true, "label")
 	if trueResult.Status != gopter.PropTrue || trueResult.Labels[0] != "label" {
@@ -173,3 +173,34 @@ func TestPropPassedMulti(t *testing.T) {
 		t.Errorf("Invalid number of calls: %d", called)
 	}
 }
+
+func TestPropFailedMultiDeterministic(t *testing.T) {
+	prop := Prop(func(genParams *GenParameters) *PropResult {
+		value := genParams.NextUint64()
+		if value%7 == 0 {
+			return &PropResult{
+				Status: PropFalse,
+				Args:   []*PropArg{NewPropArg(&GenResult{Result: value}, 0, value, value)},
+			}
+		}
+		return &PropResult{
+			Status: PropTrue,
+		}
+	})
+
+	var first *TestResult
+	for i := 0; i < 10; i++ {
+		parameters := DefaultTestParametersWithSeed(1234)
+		parameters.Workers = 4
+		result := prop.Check(parameters)
+		if result.Status != TestFailed || len(result.Args) != 1 {
+			t.Fatalf("Invalid result: %#v", result)
+		}
+		if first == nil {
+			first = result
+		} else if result.Args[0].Arg != first.Args[0].Arg ||
+			result.Succeeded != first.Succeeded || result.Discarded != first.Discarded {
+			t.Errorf("Result is not deterministic: %#v != %#v", result, first)
+		}
+	}
+}
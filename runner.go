@@ -7,7 +7,10 @@ import (
 
 type shouldStop func() bool
 
-type worker func(int, shouldStop) *TestResult
+// worker runs the test cases workerIdx, workerIdx + Workers, workerIdx + 2 * Workers, ...
+// with its own sub-seed. If the result is decisive (i.e. failed, proved or an error) the index
+// of the deciding test case is returned as well.
+type worker func(workerIdx int, subSeed int64, shouldStop shouldStop) (*TestResult, int)
 
 type runner struct {
 	sync.RWMutex
@@ -15,16 +18,29 @@ type runner struct {
 	worker     worker
 }
 
-func (r *runner) mergeCheckResults(r1, r2 *TestResult) *TestResult {
+// workerResult is the result of a worker with the index of the deciding test case
+type workerResult struct {
+	*TestResult
+	caseIdx int
+}
+
+func (r *workerResult) decisive() bool {
+	return r.Status != TestPassed && r.Status != TestExhausted
+}
+
+func (r *runner) mergeCheckResults(r1, r2 *workerResult) *workerResult {
 	var result TestResult
+	caseIdx := 0
 
 	switch {
 	case r1 == nil:
 		return r2
-	case r1.Status != TestPassed && r1.Status != TestExhausted:
-		result = *r1
-	case r2.Status != TestPassed && r2.Status != TestExhausted:
-		result = *r2
+	case r1.decisive() && (!r2.decisive() || r1.caseIdx <= r2.caseIdx):
+		result = *r1.TestResult
+		caseIdx = r1.caseIdx
+	case r2.decisive():
+		result = *r2.TestResult
+		caseIdx = r2.caseIdx
 	default:
 		result.Status = TestExhausted
 
@@ -37,7 +53,17 @@ func (r *runner) mergeCheckResults(r1, r2 *TestResult) *TestResult {
 	result.Succeeded = r1.Succeeded + r2.Succeeded
 	result.Discarded = r1.Discarded + r2.Discarded
 
-	return &result
+	return &workerResult{TestResult: &result, caseIdx: caseIdx}
+}
+
+// subSeeds derives a deterministic sub-seed for every worker from the Rng of the parameters
+func (r *runner) subSeeds(workers int) []int64 {
+	source := NewSplitMixSource(r.parameters.Rng.Int63())
+	subSeeds := make([]int64, workers)
+	for i := range subSeeds {
+		subSeeds[i] = source.Split().Int63()
+	}
+	return subSeeds
 }
 
 func (r *runner) runWorkers() *TestResult {
@@ -46,32 +72,29 @@ func (r *runner) runWorkers() *TestResult {
 
 	start := time.Now()
 	if r.parameters.Workers < 2 {
-		result := r.worker(0, stopFlag.Get)
+		result, _ := r.worker(0, r.subSeeds(1)[0], stopFlag.Get)
 		result.Time = time.Since(start)
 		return result
 	}
+	subSeeds := r.subSeeds(r.parameters.Workers)
 	var waitGroup sync.WaitGroup
 	waitGroup.Add(r.parameters.Workers)
-	results := make(chan *TestResult, r.parameters.Workers)
-	combinedResult := make(chan *TestResult)
+	results := make([]*workerResult, r.parameters.Workers)
 
-	go func() {
-		var combined *TestResult
-		for result := range results {
-			combined = r.mergeCheckResults(combined, result)
-		}
-		combinedResult <- combined
-	}()
 	for i := 0; i < r.parameters.Workers; i++ {
 		go func(workerIdx int) {
 			defer waitGroup.Done()
-			results <- r.worker(workerIdx, stopFlag.Get)
+			result, caseIdx := r.worker(workerIdx, subSeeds[workerIdx], stopFlag.Get)
+			results[workerIdx] = &workerResult{TestResult: result, caseIdx: caseIdx}
 		}(i)
 	}
 	waitGroup.Wait()
-	close(results)
 
-	result := <-combinedResult
-	result.Time = time.Since(start)
-	return result
+	// merged in the order of the workers, so that the result does not depend on the scheduling
+	var combined *workerResult
+	for _, result := range results {
+		combined = r.mergeCheckResults(combined, result)
+	}
+	combined.Time = time.Since(start)
+	return combined.TestResult
 }
@@ -3,6 +3,7 @@ package gopter
 import (
 	"errors"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 )
@@ -11,12 +12,12 @@ func TestRunnerSingleWorker(t *testing.T) {
 	parameters := DefaultTestParameters()
 	testRunner := &runner{
 		parameters: parameters,
-		worker: func(num int, shouldStop shouldStop) *TestResult {
+		worker: func(num int, subSeed int64, shouldStop shouldStop) (*TestResult, int) {
 			return &TestResult{
 				Status:    TestPassed,
 				Succeeded: 1,
 				Discarded: 0,
-			}
+			}, 0
 		},
 	}
 
@@ -36,6 +37,7 @@ func TestRunnerParallelWorkers(t *testing.T) {
 		res     []TestResult
 		exp     *TestResult
 		wait    []int
+		caseIdx []int
 	}{
 		// Test all pass
 		{
@@ -115,7 +117,7 @@ func TestRunnerParallelWorkers(t *testing.T) {
 			},
 			wait: []int{1, 0},
 		},
-		// a pass and multiple failures (first failure returned)
+		// a pass and multiple failures (failure with lowest case index returned)
 		{
 			workers: 3,
 			res: []TestResult{
@@ -143,10 +145,11 @@ func TestRunnerParallelWorkers(t *testing.T) {
 				Status:    TestFailed,
 				Succeeded: 98,
 				Discarded: 4,
-				Labels:    []string{"worker 1"},
-				Error:     errors.New("worker 1 error"),
+				Labels:    []string{"worker 2"},
+				Error:     errors.New("worker 2 error"),
 			},
-			wait: []int{0, 1, 2},
+			wait:    []int{0, 1, 2},
+			caseIdx: []int{0, 16, 8},
 		},
 	}
 
@@ -155,16 +158,20 @@ func TestRunnerParallelWorkers(t *testing.T) {
 
 		testRunner := &runner{
 			parameters: parameters,
-			worker: func(num int, shouldStop shouldStop) *TestResult {
+			worker: func(num int, subSeed int64, shouldStop shouldStop) (*TestResult, int) {
 				if num < len(spec.wait) {
 					time.Sleep(time.Duration(spec.wait[num]) * time.Second)
 				}
+				caseIdx := num
+				if num < len(spec.caseIdx) {
+					caseIdx = spec.caseIdx[num]
+				}
 
 				if num < len(spec.res) {
-					return &spec.res[num]
+					return &spec.res[num], caseIdx
 				}
 
-				return &spec.res[0]
+				return &spec.res[0], caseIdx
 			},
 		}
 
@@ -187,3 +194,36 @@ func TestRunnerParallelWorkers(t *testing.T) {
 		}
 	}
 }
+
+func TestRunnerSubSeeds(t *testing.T) {
+	seeds := func() []int64 {
+		parameters := DefaultTestParametersWithSeed(1234)
+		parameters.Workers = 4
+		var mutex sync.Mutex
+		seeds := make([]int64, parameters.Workers)
+		testRunner := &runner{
+			parameters: parameters,
+			worker: func(num int, subSeed int64, shouldStop shouldStop) (*TestResult, int) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				seeds[num] = subSeed
+				return &TestResult{Status: TestPassed}, 0
+			},
+		}
+		testRunner.runWorkers()
+		return seeds
+	}
+
+	seeds1 := seeds()
+	seeds2 := seeds()
+	if !reflect.DeepEqual(seeds1, seeds2) {
+		t.Errorf("Sub-seeds are not deterministic: %v != %v", seeds1, seeds2)
+	}
+	distinct := map[int64]bool{}
+	for _, seed := range seeds1 {
+		distinct[seed] = true
+	}
+	if len(distinct) != len(seeds1) {
+		t.Errorf("Sub-seeds are not distinct: %v", seeds1)
+	}
+}
@@ -0,0 +1,81 @@
+package gopter
+
+import (
+	"math/bits"
+	"math/rand"
+)
+
+// SplittableSource is a source of random numbers that can be split into an
+// independent stream. Splitting is deterministic, i.e. the streams of a source
+// and all its splits only depend on the initial seed.
+// Contrary to the source created by NewLockedSource a SplittableSource is not
+// supposed to be safe for concurrent use: Every goroutine should use its own split.
+type SplittableSource interface {
+	rand.Source64
+	// Split creates a new independent source, advancing the state of this source
+	Split() SplittableSource
+}
+
+const splitMixGoldenGamma = 0x9e3779b97f4a7c15
+
+// splitMixSource is an implementation of the SplitMix64 algorithm by Steele, Lea and Flood
+// ("Fast splittable pseudorandom number generators")
+type splitMixSource struct {
+	seed  uint64
+	gamma uint64
+}
+
+// NewSplitMixSource takes a seed and returns a new (lock-free) SplittableSource
+// for use with rand.New
+func NewSplitMixSource(seed int64) SplittableSource {
+	return &splitMixSource{
+		seed:  uint64(seed),
+		gamma: splitMixGoldenGamma,
+	}
+}
+
+func (s *splitMixSource) nextSeed() uint64 {
+	s.seed += s.gamma
+	return s.seed
+}
+
+func (s *splitMixSource) Uint64() uint64 {
+	return splitMix64(s.nextSeed())
+}
+
+func (s *splitMixSource) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+func (s *splitMixSource) Seed(seed int64) {
+	s.seed = uint64(seed)
+	s.gamma = splitMixGoldenGamma
+}
+
+func (s *splitMixSource) Split() SplittableSource {
+	return &splitMixSource{
+		seed:  murmurMix64(s.nextSeed()),
+		gamma: splitMixGamma(s.nextSeed()),
+	}
+}
+
+func splitMix64(z uint64) uint64 {
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+func murmurMix64(z uint64) uint64 {
+	z = (z ^ (z >> 33)) * 0xff51afd7ed558ccd
+	z = (z ^ (z >> 33)) * 0xc4ceb9fe1a85ec53
+	return z ^ (z >> 33)
+}
+
+// splitMixGamma creates an odd gamma with enough bit transitions
+func splitMixGamma(z uint64) uint64 {
+	z = splitMix64(z) | 1
+	if bits.OnesCount64(z^(z>>1)) < 24 {
+		z ^= 0xaaaaaaaaaaaaaaaa
+	}
+	return z
+}
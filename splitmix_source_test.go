@@ -0,0 +1,105 @@
+package gopter_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/leanovate/gopter"
+)
+
+func TestSplitMixSource(t *testing.T) {
+	source1 := gopter.NewSplitMixSource(1234)
+	source2 := gopter.NewSplitMixSource(1234)
+	for i := 0; i < 100; i++ {
+		if source1.Uint64() != source2.Uint64() {
+			t.Fatal("sources with same seed create different random numbers")
+		}
+	}
+
+	split1 := source1.Split()
+	split2 := source2.Split()
+	equal := 0
+	for i := 0; i < 100; i++ {
+		value := split1.Int63()
+		if value < 0 || value != split2.Int63() {
+			t.Fatalf("Invalid split: %d", value)
+		}
+		if value == source1.Int63() {
+			equal++
+		}
+	}
+	if equal > 1 {
+		t.Errorf("split is not independent: %d equal values", equal)
+	}
+
+	source1.Seed(42)
+	source2.Seed(42)
+	if source1.Int63() != source2.Int63() {
+		t.Error("sources reseeded with same seed create different random numbers")
+	}
+}
+
+func TestGenParametersSplit(t *testing.T) {
+	parameters1 := gopter.DefaultGenParameters().CloneWithSeed(1234)
+	parameters2 := gopter.DefaultGenParameters().CloneWithSeed(1234)
+	split1 := parameters1.Split()
+	split2 := parameters2.Split()
+	for i := 0; i < 100; i++ {
+		if split1.NextInt64() != split2.NextInt64() || parameters1.NextInt64() != parameters2.NextInt64() {
+			t.Fatal("split parameters create different random numbers")
+		}
+	}
+
+	parameters1.Rng = rand.New(rand.NewSource(1))
+	parameters2.Rng = rand.New(rand.NewSource(1))
+	split1 = parameters1.Split()
+	split2 = parameters2.Split()
+	for i := 0; i < 100; i++ {
+		if split1.NextInt64() != split2.NextInt64() {
+			t.Fatal("split of replaced Rng creates different random numbers")
+		}
+	}
+
+	parameters := gopter.DefaultGenParameters().WithSource(rand.NewSource(1))
+	if split := parameters.Split(); split.Rng == parameters.Rng {
+		t.Error("split should use a new Rng")
+	}
+}
+
+func BenchmarkLockedSource(b *testing.B) {
+	source := gopter.NewLockedSource(1234)
+	for i := 0; i < b.N; i++ {
+		source.Int63()
+	}
+}
+
+func BenchmarkSplitMixSource(b *testing.B) {
+	source := gopter.NewSplitMixSource(1234)
+	for i := 0; i < b.N; i++ {
+		source.Int63()
+	}
+}
+
+func benchmarkPropCheck(b *testing.B, workers int) {
+	prop := gopter.Prop(func(genParams *gopter.GenParameters) *gopter.PropResult {
+		for i := 0; i < 100; i++ {
+			genParams.NextUint64()
+		}
+		return &gopter.PropResult{Status: gopter.PropTrue}
+	})
+	parameters := gopter.DefaultTestParametersWithSeed(1234)
+	parameters.MinSuccessfulTests = 1000
+	parameters.Workers = workers
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		prop.Check(parameters)
+	}
+}
+
+func BenchmarkPropCheck(b *testing.B) {
+	benchmarkPropCheck(b, 1)
+}
+
+func BenchmarkPropCheckWorkers(b *testing.B) {
+	benchmarkPropCheck(b, 4)
+}
@@ -17,19 +17,33 @@ type TestParameters struct {
 	Rng             *rand.Rand
 	Workers         int
 	MaxDiscardRatio float64
+	// EdgeCaseProbability is the probability that generators produce edge
+	// cases (see GenParameters.EdgeCaseProbability)
+	EdgeCaseProbability float64
+	// values is the user-defined environment passed to the generators (see WithValue)
+	values *genValue
+}
+
+// WithValue creates a copy of the parameters with an additional entry in the
+// user-defined environment of the generators (see GenParameters.WithValue).
+func (p *TestParameters) WithValue(key, value interface{}) *TestParameters {
+	newParameters := *p
+	newParameters.values = newGenValue(p.values, key, value)
+	return &newParameters
 }
 
 // DefaultTestParameterWithSeeds creates reasonable default Parameters for most cases based on a fixed RNG-seed
 func DefaultTestParametersWithSeed(seed int64) *TestParameters {
 	return &TestParameters{
-		MinSuccessfulTests: 100,
-		MinSize:            0,
-		MaxSize:            100,
-		MaxShrinkCount:     1000,
-		Seed:               seed,
-		Rng:                rand.New(NewLockedSource(seed)),
-		Workers:            1,
-		MaxDiscardRatio:    5,
+		MinSuccessfulTests:  100,
+		MinSize:             0,
+		MaxSize:             100,
+		MaxShrinkCount:      1000,
+		Seed:                seed,
+		Rng:                 rand.New(NewLockedSource(seed)),
+		Workers:             1,
+		MaxDiscardRatio:     5,
+		EdgeCaseProbability: DefaultEdgeCaseProbability,
 	}
 }
 
@@ -42,6 +42,7 @@ type TestResult struct {
 	Labels    []string
 	Error     error
 	Args      PropArgs
+	Trace     []*TraceStep
 	Time      time.Duration
 }
 
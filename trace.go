@@ -0,0 +1,20 @@
+package gopter
+
+// TraceStep is a step of the execution of a property, e.g. a command applied in a stateful test
+// (see the commands package). The trace of a falsified property is reported with its labels.
+// The states are recorded by their string representation, as a state might be modified in place
+// by later steps.
+type TraceStep struct {
+	// Name of the step (e.g. the command)
+	Name string
+	// PreState is the expected state before the step
+	PreState string
+	// PostState is the expected state after the step
+	PostState string
+	// Result is the actual result of the step
+	Result interface{}
+	// Status is the verdict of the step (e.g. of a postcondition)
+	Status propStatus
+	// Labels of the verdict
+	Labels []string
+}